@@ -46,6 +46,37 @@ const (
 	featureServer
 	featureCreateRoleSelfGrant
 	featureSecurityLabel
+	featureMaterializedView
+	featureViewSecurityInvoker
+	featureRestrictivePolicy
+	featureDBLocaleProvider
+	featureDBICURules
+	featureDBBuiltinLocaleProvider
+	featureDBCollationVersion
+	featurePubTablesInSchema
+	featurePubRowFilter
+	featurePubColumnList
+	featureSubscriptionStreaming
+	featureSubscriptionBinary
+	featureSubscriptionStreamingParallel
+	featureSubscriptionTwoPhase
+	featureSubscriptionDisableOnError
+	featureSubscriptionOrigin
+	featureSubscriptionPasswordRequired
+	featureSubscriptionRunAsOwner
+	featureSubscriptionFailover
+	featureSubscriptionAddDropPublication
+	featureReplicationSlotWALStatus
+	featureReplicationSlotTwoPhase
+	featureReplicationSlotConflicting
+	featureReplicationSlotFailover
+	featureWALFunctions
+	featureParameterPrivileges
+	featureDefaultPrivilegesLargeObjects
+	featureRoleMembershipOptions
+	featureFunctionSupport
+	featureAggregateParallel
+	featureAlterOperator
 )
 
 var (
@@ -122,6 +153,91 @@ var (
 		// https://www.postgresql.org/docs/16/release-16.html#RELEASE-16-PRIVILEGES
 		featureCreateRoleSelfGrant: semver.MustParseRange(">=16.0.0"),
 		featureSecurityLabel:       semver.MustParseRange(">=11.0.0"),
+
+		// CREATE MATERIALIZED VIEW support
+		featureMaterializedView: semver.MustParseRange(">=9.3.0"),
+
+		// CREATE VIEW has security_invoker option
+		featureViewSecurityInvoker: semver.MustParseRange(">=15.0.0"),
+
+		// CREATE POLICY has AS RESTRICTIVE support
+		featureRestrictivePolicy: semver.MustParseRange(">=10.0.0"),
+
+		// CREATE DATABASE has LOCALE_PROVIDER and ICU_LOCALE options
+		featureDBLocaleProvider: semver.MustParseRange(">=15.0.0"),
+
+		// CREATE DATABASE has ICU_RULES option
+		featureDBICURules: semver.MustParseRange(">=16.0.0"),
+
+		// CREATE DATABASE has the builtin locale provider and BUILTIN_LOCALE option
+		featureDBBuiltinLocaleProvider: semver.MustParseRange(">=17.0.0"),
+
+		// ALTER DATABASE ... REFRESH COLLATION VERSION support
+		featureDBCollationVersion: semver.MustParseRange(">=15.0.0"),
+
+		// CREATE PUBLICATION ... FOR TABLES IN SCHEMA support
+		featurePubTablesInSchema: semver.MustParseRange(">=15.0.0"),
+
+		// CREATE PUBLICATION ... FOR TABLE ... WHERE (row filter) support
+		featurePubRowFilter: semver.MustParseRange(">=15.0.0"),
+
+		// CREATE PUBLICATION ... FOR TABLE with column lists support
+		featurePubColumnList: semver.MustParseRange(">=15.0.0"),
+
+		// CREATE SUBSCRIPTION has streaming and binary options
+		featureSubscriptionStreaming: semver.MustParseRange(">=14.0.0"),
+		featureSubscriptionBinary:    semver.MustParseRange(">=14.0.0"),
+
+		// CREATE SUBSCRIPTION has streaming = parallel option
+		featureSubscriptionStreamingParallel: semver.MustParseRange(">=16.0.0"),
+
+		// CREATE SUBSCRIPTION has two_phase and disable_on_error options
+		featureSubscriptionTwoPhase:       semver.MustParseRange(">=15.0.0"),
+		featureSubscriptionDisableOnError: semver.MustParseRange(">=15.0.0"),
+
+		// CREATE SUBSCRIPTION has origin, password_required and run_as_owner options
+		featureSubscriptionOrigin:           semver.MustParseRange(">=16.0.0"),
+		featureSubscriptionPasswordRequired: semver.MustParseRange(">=16.0.0"),
+		featureSubscriptionRunAsOwner:       semver.MustParseRange(">=16.0.0"),
+
+		// CREATE SUBSCRIPTION has failover option
+		featureSubscriptionFailover: semver.MustParseRange(">=17.0.0"),
+
+		// ALTER SUBSCRIPTION ... ADD / DROP PUBLICATION support
+		featureSubscriptionAddDropPublication: semver.MustParseRange(">=14.0.0"),
+
+		// pg_replication_slots has wal_status and safe_wal_size columns
+		featureReplicationSlotWALStatus: semver.MustParseRange(">=13.0.0"),
+
+		// pg_create_logical_replication_slot has twophase parameter
+		featureReplicationSlotTwoPhase: semver.MustParseRange(">=14.0.0"),
+
+		// pg_replication_slots has conflicting column
+		featureReplicationSlotConflicting: semver.MustParseRange(">=16.0.0"),
+
+		// pg_create_logical_replication_slot has failover parameter
+		featureReplicationSlotFailover: semver.MustParseRange(">=17.0.0"),
+
+		// pg_xlog functions and columns were renamed to pg_wal (e.g.: pg_wal_lsn_diff)
+		featureWALFunctions: semver.MustParseRange(">=10.0.0"),
+
+		// GRANT SET / ALTER SYSTEM ON PARAMETER
+		featureParameterPrivileges: semver.MustParseRange(">=15.0.0"),
+
+		// ALTER DEFAULT PRIVILEGES ... ON LARGE OBJECTS
+		featureDefaultPrivilegesLargeObjects: semver.MustParseRange(">=18.0.0"),
+
+		// GRANT role ... WITH INHERIT / SET options and pg_auth_members inherit_option / set_option
+		featureRoleMembershipOptions: semver.MustParseRange(">=16.0.0"),
+
+		// CREATE FUNCTION ... SUPPORT and pg_proc.prosupport
+		featureFunctionSupport: semver.MustParseRange(">=12.0.0"),
+
+		// CREATE AGGREGATE has COMBINEFUNC and PARALLEL options
+		featureAggregateParallel: semver.MustParseRange(">=9.6.0"),
+
+		// ALTER OPERATOR ... SET (RESTRICT, JOIN)
+		featureAlterOperator: semver.MustParseRange(">=9.6.0"),
 	}
 )
 
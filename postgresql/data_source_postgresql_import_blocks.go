@@ -0,0 +1,1440 @@
+package postgresql
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/lib/pq"
+)
+
+const (
+	importBlocksDatabasesAttr     = "databases"
+	importBlocksResourceTypesAttr = "resource_types"
+	importBlocksResourcesAttr     = "resources"
+	importBlocksContentAttr       = "content"
+
+	importBlockTypeAttr   = "type"
+	importBlockNameAttr   = "name"
+	importBlockIDAttr     = "id"
+	importBlockConfigAttr = "config"
+)
+
+// importBlocksResourceTypes are the resource types which can be discovered by the import blocks data source.
+var importBlocksResourceTypes = []string{
+	"postgresql_role",
+	"postgresql_database",
+	"postgresql_schema",
+	"postgresql_extension",
+	"postgresql_function",
+	"postgresql_procedure",
+	"postgresql_aggregate",
+	"postgresql_operator",
+	"postgresql_cast",
+	"postgresql_publication",
+	"postgresql_subscription",
+	"postgresql_server",
+	"postgresql_grant",
+	"postgresql_grant_role",
+	"postgresql_default_privileges",
+	"postgresql_tablespace",
+	"postgresql_table",
+	"postgresql_row_level_security",
+	"postgresql_view",
+	"postgresql_materialized_view",
+	"postgresql_policy",
+	"postgresql_replication_slot",
+	"postgresql_physical_replication_slot",
+	"postgresql_role_database_settings",
+}
+
+// importBlock is an existing object for which an import block and a resource configuration are generated.
+type importBlock struct {
+	resourceType string
+	name         string
+	id           string
+	config       string
+}
+
+// importBlocksDiscovery walks the catalog of a cluster to build the import blocks.
+type importBlocksDiscovery struct {
+	db            *DBConnection
+	resourceTypes []string
+	blocks        []importBlock
+
+	// usedNames contains the Terraform names already used per resource type.
+	usedNames map[string]map[string]bool
+}
+
+func dataSourcePostgreSQLImportBlocks() *schema.Resource {
+	return &schema.Resource{
+		Read: PGResourceFunc(dataSourcePostgreSQLImportBlocksRead),
+		Schema: map[string]*schema.Schema{
+			importBlocksDatabasesAttr: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The databases in which objects are discovered. Defaults to all the databases accepting connections, except templates",
+			},
+			importBlocksResourceTypesAttr: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(importBlocksResourceTypes, false),
+				},
+				Set:         schema.HashString,
+				Description: "The resource types to discover (one of: " + strings.Join(importBlocksResourceTypes, ", ") + "). Defaults to all of them",
+			},
+			importBlocksResourcesAttr: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The discovered resources",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						importBlockTypeAttr: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type",
+						},
+						importBlockNameAttr: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Terraform name of the resource",
+						},
+						importBlockIDAttr: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The import ID of the resource",
+						},
+						importBlockConfigAttr: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource configuration",
+						},
+					},
+				},
+			},
+			importBlocksContentAttr: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The import blocks and resource configurations of all the discovered resources",
+			},
+		},
+	}
+}
+
+func dataSourcePostgreSQLImportBlocksRead(db *DBConnection, d *schema.ResourceData) error {
+	resourceTypes := importBlocksResourceTypes
+	if v, ok := d.GetOk(importBlocksResourceTypesAttr); ok {
+		resourceTypes = []string{}
+		for _, resourceType := range v.(*schema.Set).List() {
+			resourceTypes = append(resourceTypes, resourceType.(string))
+		}
+	}
+
+	databases := []string{}
+	for _, database := range d.Get(importBlocksDatabasesAttr).([]any) {
+		databases = append(databases, database.(string))
+	}
+	if len(databases) == 0 {
+		var err error
+		if databases, err = listImportBlocksDatabases(db); err != nil {
+			return err
+		}
+	}
+
+	discovery := &importBlocksDiscovery{
+		db:            db,
+		resourceTypes: resourceTypes,
+		usedNames:     map[string]map[string]bool{},
+	}
+	if err := discovery.discover(databases); err != nil {
+		return err
+	}
+
+	resources := make([]any, 0, len(discovery.blocks))
+	content := bytes.NewBufferString("")
+	for i, block := range discovery.blocks {
+		resources = append(resources, map[string]any{
+			importBlockTypeAttr:   block.resourceType,
+			importBlockNameAttr:   block.name,
+			importBlockIDAttr:     block.id,
+			importBlockConfigAttr: block.config,
+		})
+
+		if i > 0 {
+			fmt.Fprintln(content)
+		}
+		fmt.Fprintf(content, "import {\n  to = %s.%s\n  id = %s\n}\n\n", block.resourceType, block.name, hclQuote(block.id))
+		fmt.Fprint(content, block.config)
+	}
+
+	d.Set(importBlocksResourcesAttr, resources)
+	d.Set(importBlocksContentAttr, content.String())
+	d.SetId(generateDataSourceImportBlocksID(databases, resourceTypes))
+
+	return nil
+}
+
+func generateDataSourceImportBlocksID(databases, resourceTypes []string) string {
+	sortedTypes := append([]string{}, resourceTypes...)
+	sort.Strings(sortedTypes)
+
+	return strings.Join([]string{
+		strings.Join(databases, ","), strings.Join(sortedTypes, ","),
+	}, "_")
+}
+
+func listImportBlocksDatabases(db *DBConnection) ([]string, error) {
+	return queryNames(db, "SELECT datname FROM pg_catalog.pg_database WHERE NOT datistemplate AND datallowconn ORDER BY datname")
+}
+
+// queryNames returns the values of the first column returned by the query.
+func queryNames(db QueryAble, query string, args ...any) ([]string, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not list objects: %w", err)
+	}
+	defer rows.Close()
+
+	names := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("could not scan object name: %w", err)
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not list objects: %w", err)
+	}
+
+	return names, nil
+}
+
+func (discovery *importBlocksDiscovery) enabled(resourceType string) bool {
+	return sliceContainsStr(discovery.resourceTypes, resourceType)
+}
+
+func (discovery *importBlocksDiscovery) discover(databases []string) error {
+	if discovery.enabled("postgresql_role") {
+		if err := discovery.discoverRoles(); err != nil {
+			return err
+		}
+	}
+
+	// The memberships are part of the postgresql_role configuration (roles attribute),
+	// they are only generated as postgresql_grant_role if the roles are not discovered.
+	if discovery.enabled("postgresql_grant_role") && !discovery.enabled("postgresql_role") {
+		if err := discovery.discoverGrantRoles(); err != nil {
+			return err
+		}
+	}
+
+	if discovery.enabled("postgresql_tablespace") {
+		if err := discovery.discoverTablespaces(); err != nil {
+			return err
+		}
+	}
+
+	if discovery.enabled("postgresql_physical_replication_slot") {
+		if err := discovery.discoverPhysicalReplicationSlots(); err != nil {
+			return err
+		}
+	}
+
+	if discovery.enabled("postgresql_database") {
+		for _, database := range databases {
+			if err := discovery.addResource("postgresql_database", resourcePostgreSQLDatabase(), database, func(d *schema.ResourceData) (string, error) {
+				d.Set(dbNameAttr, database)
+				return database, nil
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, database := range databases {
+		if err := discovery.discoverDatabaseObjects(database); err != nil {
+			return fmt.Errorf("could not discover objects of database %s: %w", database, err)
+		}
+	}
+
+	if discovery.enabled("postgresql_role_database_settings") {
+		if err := discovery.discoverRoleDatabaseSettings(databases); err != nil {
+			return err
+		}
+	}
+
+	if discovery.enabled("postgresql_server") {
+		// postgresql_server has no database attribute so only the servers of the provider database can be managed.
+		if err := discovery.discoverServers(); err != nil {
+			return err
+		}
+	}
+
+	if discovery.enabled("postgresql_grant") {
+		if err := discovery.discoverDatabaseGrants(databases); err != nil {
+			return err
+		}
+		if err := discovery.discoverTablespaceGrants(); err != nil {
+			return err
+		}
+		for _, database := range databases {
+			if err := discovery.discoverSchemaObjectGrants(database); err != nil {
+				return fmt.Errorf("could not discover grants of database %s: %w", database, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (discovery *importBlocksDiscovery) discoverRoles() error {
+	roles, err := queryNames(discovery.db, "SELECT rolname FROM pg_catalog.pg_roles WHERE rolname !~ '^pg_' ORDER BY rolname")
+	if err != nil {
+		return err
+	}
+
+	for _, role := range roles {
+		if err := discovery.addResource("postgresql_role", resourcePostgreSQLRole(), role, func(d *schema.ResourceData) (string, error) {
+			d.Set(roleNameAttr, role)
+			return role, nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (discovery *importBlocksDiscovery) discoverDatabaseObjects(database string) error {
+	txn, err := startTransaction(discovery.db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if discovery.enabled("postgresql_schema") {
+		schemas, err := queryNames(txn, `SELECT nspname FROM pg_catalog.pg_namespace WHERE nspname !~ '^pg_' AND nspname <> 'information_schema' ORDER BY nspname`)
+		if err != nil {
+			return err
+		}
+		for _, schemaName := range schemas {
+			if err := discovery.addResource("postgresql_schema", resourcePostgreSQLSchema(), database+"_"+schemaName, func(d *schema.ResourceData) (string, error) {
+				d.Set(schemaNameAttr, schemaName)
+				d.Set(schemaDatabaseAttr, database)
+				return generateSchemaID(d, database), nil
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if discovery.enabled("postgresql_extension") && discovery.db.featureSupported(featureExtension) {
+		// plpgsql is installed by default in every database.
+		extensions, err := queryNames(txn, "SELECT extname FROM pg_catalog.pg_extension WHERE extname <> 'plpgsql' ORDER BY extname")
+		if err != nil {
+			return err
+		}
+		for _, extension := range extensions {
+			if err := discovery.addResource("postgresql_extension", resourcePostgreSQLExtension(), database+"_"+extension, func(d *schema.ResourceData) (string, error) {
+				d.Set(extNameAttr, extension)
+				d.Set(extDatabaseAttr, database)
+				return generateExtensionID(d, database), nil
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if discovery.enabled("postgresql_table") || (discovery.enabled("postgresql_row_level_security") && discovery.db.featureSupported(featureRLS)) {
+		if err := discovery.discoverTables(txn, database); err != nil {
+			return err
+		}
+	}
+
+	if discovery.enabled("postgresql_view") {
+		if err := discovery.discoverViews(txn, database, "postgresql_view", resourcePostgreSQLView(), "v"); err != nil {
+			return err
+		}
+	}
+
+	if discovery.enabled("postgresql_materialized_view") && discovery.db.featureSupported(featureMaterializedView) {
+		if err := discovery.discoverViews(txn, database, "postgresql_materialized_view", resourcePostgreSQLMaterializedView(), "m"); err != nil {
+			return err
+		}
+	}
+
+	if discovery.enabled("postgresql_policy") && discovery.db.featureSupported(featureRLS) {
+		if err := discovery.discoverPolicies(txn, database); err != nil {
+			return err
+		}
+	}
+
+	if discovery.enabled("postgresql_default_privileges") {
+		if err := discovery.discoverDefaultPrivileges(txn, database); err != nil {
+			return err
+		}
+	}
+
+	if discovery.enabled("postgresql_replication_slot") {
+		slots, err := queryNames(txn,
+			"SELECT slot_name FROM pg_catalog.pg_replication_slots WHERE slot_type = 'logical' AND database = $1 ORDER BY slot_name",
+			database,
+		)
+		if err != nil {
+			return err
+		}
+		for _, slot := range slots {
+			if err := discovery.addResource("postgresql_replication_slot", resourcePostgreSQLReplicationSlot(), database+"_"+slot, func(d *schema.ResourceData) (string, error) {
+				d.Set("name", slot)
+				d.Set("database", database)
+				return generateReplicationSlotID(d, database), nil
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if discovery.enabled("postgresql_function") && discovery.db.featureSupported(featureFunction) {
+		if err := discovery.discoverFunctions(txn, database); err != nil {
+			return err
+		}
+	}
+
+	if discovery.enabled("postgresql_procedure") && discovery.db.featureSupported(featureProcedure) {
+		if err := discovery.discoverProcedures(txn, database); err != nil {
+			return err
+		}
+	}
+
+	if discovery.enabled("postgresql_aggregate") {
+		if err := discovery.discoverAggregates(txn, database); err != nil {
+			return err
+		}
+	}
+
+	if discovery.enabled("postgresql_operator") {
+		if err := discovery.discoverOperators(txn, database); err != nil {
+			return err
+		}
+	}
+
+	if discovery.enabled("postgresql_cast") {
+		if err := discovery.discoverCasts(txn, database); err != nil {
+			return err
+		}
+	}
+
+	if discovery.enabled("postgresql_publication") && discovery.db.featureSupported(featurePublication) {
+		publications, err := queryNames(txn, "SELECT pubname FROM pg_catalog.pg_publication ORDER BY pubname")
+		if err != nil {
+			return err
+		}
+		for _, publication := range publications {
+			if err := discovery.addResource("postgresql_publication", resourcePostgreSQLPublication(), database+"_"+publication, func(d *schema.ResourceData) (string, error) {
+				d.Set(pubNameAttr, publication)
+				d.Set(pubDatabaseAttr, database)
+				return generatePublicationID(d, database), nil
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if discovery.enabled("postgresql_subscription") && discovery.db.featureSupported(featurePublication) {
+		subscriptions, err := queryNames(txn,
+			"SELECT s.subname FROM pg_catalog.pg_subscription s JOIN pg_catalog.pg_database d ON d.oid = s.subdbid "+
+				"WHERE d.datname = $1 ORDER BY s.subname",
+			database,
+		)
+		if err != nil {
+			return err
+		}
+		for _, subscription := range subscriptions {
+			if err := discovery.addResource("postgresql_subscription", resourcePostgreSQLSubscription(), database+"_"+subscription, func(d *schema.ResourceData) (string, error) {
+				d.Set("name", subscription)
+				d.Set("database", database)
+				return generateSubscriptionID(d, database), nil
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (discovery *importBlocksDiscovery) discoverFunctions(txn *sql.Tx, database string) error {
+	// Functions created by extensions are managed by the extensions.
+	query := `SELECT pg_catalog.pg_get_functiondef(p.oid) FROM pg_catalog.pg_proc p ` +
+		`JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace ` +
+		`WHERE n.nspname !~ '^pg_' AND n.nspname <> 'information_schema' ` +
+		`AND NOT EXISTS (SELECT 1 FROM pg_catalog.pg_depend dep WHERE dep.classid = 'pg_catalog.pg_proc'::regclass AND dep.objid = p.oid AND dep.deptype = 'e') `
+	if discovery.db.featureSupported(featureProcedure) {
+		query += `AND p.prokind = 'f' `
+	} else {
+		query += `AND NOT p.proisagg AND NOT p.proiswindow `
+	}
+	query += `ORDER BY n.nspname, p.proname, p.oid`
+
+	definitions, err := queryNames(txn, query)
+	if err != nil {
+		return err
+	}
+
+	for _, definition := range definitions {
+		var pgFunction PGFunction
+		if err := pgFunction.Parse(definition); err != nil {
+			return err
+		}
+
+		if err := discovery.addResource("postgresql_function", resourcePostgreSQLFunction(), database+"_"+pgFunction.Schema+"_"+pgFunction.Name, func(d *schema.ResourceData) (string, error) {
+			var args []map[string]any
+			for _, a := range pgFunction.Args {
+				args = append(args, map[string]any{
+					funcArgTypeAttr:    a.Type,
+					funcArgNameAttr:    a.Name,
+					funcArgModeAttr:    a.Mode,
+					funcArgDefaultAttr: a.Default,
+				})
+			}
+
+			d.Set(funcNameAttr, pgFunction.Name)
+			d.Set(funcSchemaAttr, pgFunction.Schema)
+			d.Set(funcDatabaseAttr, database)
+			d.Set(funcArgAttr, args)
+			return generateFunctionID(discovery.db, d)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (discovery *importBlocksDiscovery) discoverProcedures(txn *sql.Tx, database string) error {
+	// Procedures created by extensions are managed by the extensions.
+	definitions, err := queryNames(txn,
+		`SELECT pg_catalog.pg_get_functiondef(p.oid) FROM pg_catalog.pg_proc p `+
+			`JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace `+
+			`WHERE n.nspname !~ '^pg_' AND n.nspname <> 'information_schema' AND p.prokind = 'p' `+
+			`AND NOT EXISTS (SELECT 1 FROM pg_catalog.pg_depend dep WHERE dep.classid = 'pg_catalog.pg_proc'::regclass AND dep.objid = p.oid AND dep.deptype = 'e') `+
+			`ORDER BY n.nspname, p.proname, p.oid`,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, definition := range definitions {
+		var pgProcedure PGProcedure
+		if err := pgProcedure.Parse(definition); err != nil {
+			return err
+		}
+
+		if err := discovery.addResource("postgresql_procedure", resourcePostgreSQLProcedure(), database+"_"+pgProcedure.Schema+"_"+pgProcedure.Name, func(d *schema.ResourceData) (string, error) {
+			var args []map[string]any
+			for _, a := range pgProcedure.Args {
+				args = append(args, map[string]any{
+					funcArgTypeAttr:    a.Type,
+					funcArgNameAttr:    a.Name,
+					funcArgModeAttr:    a.Mode,
+					funcArgDefaultAttr: a.Default,
+				})
+			}
+
+			d.Set(procNameAttr, pgProcedure.Name)
+			d.Set(procSchemaAttr, pgProcedure.Schema)
+			d.Set(procDatabaseAttr, database)
+			d.Set(procArgAttr, args)
+			return generateProcedureID(discovery.db, d)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (discovery *importBlocksDiscovery) discoverAggregates(txn *sql.Tx, database string) error {
+	rows, err := txn.Query(
+		`SELECT n.nspname, p.proname, ` +
+			`pg_catalog.array_to_string(ARRAY(SELECT t.typ::regtype::text FROM unnest(p.proargtypes::oid[]) WITH ORDINALITY AS t(typ, i) ORDER BY t.i), ',') ` +
+			`FROM pg_catalog.pg_aggregate a ` +
+			`JOIN pg_catalog.pg_proc p ON p.oid = a.aggfnoid ` +
+			`JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace ` +
+			`WHERE n.nspname !~ '^pg_' AND n.nspname <> 'information_schema' AND a.aggkind = 'n' ` +
+			`AND NOT EXISTS (SELECT 1 FROM pg_catalog.pg_depend dep WHERE dep.classid = 'pg_catalog.pg_proc'::regclass AND dep.objid = p.oid AND dep.deptype = 'e') ` +
+			`ORDER BY 1, 2, 3`,
+	)
+	if err != nil {
+		return fmt.Errorf("could not list aggregates: %w", err)
+	}
+	aggregates, err := scanNameRows(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, aggregate := range aggregates {
+		schemaName, name, argTypes := aggregate[0], aggregate[1], aggregate[2]
+		if err := discovery.addResource("postgresql_aggregate", resourcePostgreSQLAggregate(), database+"_"+schemaName+"_"+name, func(d *schema.ResourceData) (string, error) {
+			args := []any{}
+			if argTypes != "" {
+				for _, argType := range strings.Split(argTypes, ",") {
+					args = append(args, map[string]any{funcArgTypeAttr: argType})
+				}
+			}
+
+			d.Set(aggNameAttr, name)
+			d.Set(aggSchemaAttr, schemaName)
+			d.Set(aggDatabaseAttr, database)
+			d.Set(aggArgAttr, args)
+			return generateAggregateID(d, database), nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (discovery *importBlocksDiscovery) discoverOperators(txn *sql.Tx, database string) error {
+	// Shell operators and postfix operators cannot be managed by postgresql_operator.
+	rows, err := txn.Query(
+		`SELECT n.nspname, o.oprname, CASE WHEN o.oprleft = 0 THEN 'NONE' ELSE o.oprleft::regtype::text END, o.oprright::regtype::text ` +
+			`FROM pg_catalog.pg_operator o ` +
+			`JOIN pg_catalog.pg_namespace n ON n.oid = o.oprnamespace ` +
+			`WHERE n.nspname !~ '^pg_' AND n.nspname <> 'information_schema' AND o.oprcode::oid <> 0 AND o.oprright <> 0 ` +
+			`AND NOT EXISTS (SELECT 1 FROM pg_catalog.pg_depend dep WHERE dep.classid = 'pg_catalog.pg_operator'::regclass AND dep.objid = o.oid AND dep.deptype = 'e') ` +
+			`ORDER BY 1, 2, 3, 4`,
+	)
+	if err != nil {
+		return fmt.Errorf("could not list operators: %w", err)
+	}
+	operators, err := scanNameRows(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, operator := range operators {
+		schemaName, name, leftType, rightType := operator[0], operator[1], operator[2], operator[3]
+		if err := discovery.addResource("postgresql_operator", resourcePostgreSQLOperator(), database+"_"+schemaName+"_operator", func(d *schema.ResourceData) (string, error) {
+			d.Set(opNameAttr, name)
+			d.Set(opSchemaAttr, schemaName)
+			d.Set(opDatabaseAttr, database)
+			if leftType != "NONE" {
+				d.Set(opLeftTypeAttr, leftType)
+			}
+			d.Set(opRightTypeAttr, rightType)
+			return generateOperatorID(d, database), nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (discovery *importBlocksDiscovery) discoverCasts(txn *sql.Tx, database string) error {
+	// The casts created by initdb have an OID below FirstNormalObjectId (16384).
+	rows, err := txn.Query(
+		`SELECT c.castsource::regtype::text, c.casttarget::regtype::text FROM pg_catalog.pg_cast c ` +
+			`WHERE c.oid >= 16384 ` +
+			`AND NOT EXISTS (SELECT 1 FROM pg_catalog.pg_depend dep WHERE dep.classid = 'pg_catalog.pg_cast'::regclass AND dep.objid = c.oid AND dep.deptype = 'e') ` +
+			`ORDER BY 1, 2`,
+	)
+	if err != nil {
+		return fmt.Errorf("could not list casts: %w", err)
+	}
+	casts, err := scanNameRows(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, cast := range casts {
+		sourceType, targetType := cast[0], cast[1]
+		if err := discovery.addResource("postgresql_cast", resourcePostgreSQLCast(), database+"_"+sourceType+"_as_"+targetType, func(d *schema.ResourceData) (string, error) {
+			d.Set(castSourceTypeAttr, sourceType)
+			d.Set(castTargetTypeAttr, targetType)
+			d.Set(castDatabaseAttr, database)
+			return generateCastID(d, database), nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// discoverTables discovers the tables and their row level security.
+// The partitions and the inheritance children are not discovered as postgresql_table cannot manage them.
+func (discovery *importBlocksDiscovery) discoverTables(txn *sql.Tx, database string) error {
+	rowSecurityColumn := "'false'"
+	if discovery.db.featureSupported(featureRLS) {
+		rowSecurityColumn = "c.relrowsecurity::text"
+	}
+
+	rows, err := txn.Query(
+		`SELECT n.nspname, c.relname, ` + rowSecurityColumn + ` FROM pg_catalog.pg_class c ` +
+			`JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace ` +
+			`WHERE n.nspname !~ '^pg_' AND n.nspname <> 'information_schema' AND c.relkind IN ('r', 'p') ` +
+			`AND NOT EXISTS (SELECT 1 FROM pg_catalog.pg_inherits i WHERE i.inhrelid = c.oid) ` +
+			`AND NOT EXISTS (SELECT 1 FROM pg_catalog.pg_depend dep WHERE dep.classid = 'pg_catalog.pg_class'::regclass AND dep.objid = c.oid AND dep.deptype = 'e') ` +
+			`ORDER BY 1, 2`,
+	)
+	if err != nil {
+		return fmt.Errorf("could not list tables: %w", err)
+	}
+	tables, err := scanNameRows(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, table := range tables {
+		schemaName, tableName, rowSecurity := table[0], table[1], table[2]
+		name := database + "_" + schemaName + "_" + tableName
+
+		if discovery.enabled("postgresql_table") {
+			if err := discovery.addResource("postgresql_table", resourcePostgreSQLTable(), name, func(d *schema.ResourceData) (string, error) {
+				d.Set(tableNameAttr, tableName)
+				d.Set(tableSchemaAttr, schemaName)
+				d.Set(tableDatabaseAttr, database)
+				return generateTableID(d, database), nil
+			}); err != nil {
+				return err
+			}
+		}
+
+		if discovery.enabled("postgresql_row_level_security") && rowSecurity == "true" {
+			if err := discovery.addResource("postgresql_row_level_security", resourcePostgreSQLRowLevelSecurity(), name, func(d *schema.ResourceData) (string, error) {
+				d.Set(rlsTableAttr, tableName)
+				d.Set(rlsSchemaAttr, schemaName)
+				d.Set(rlsDatabaseAttr, database)
+				return generateRowLevelSecurityID(d, database), nil
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// discoverViews discovers the views (relkind v) or the materialized views (relkind m) of a database.
+func (discovery *importBlocksDiscovery) discoverViews(txn *sql.Tx, database, resourceType string, res *schema.Resource, relkind string) error {
+	rows, err := txn.Query(
+		`SELECT n.nspname, c.relname FROM pg_catalog.pg_class c `+
+			`JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace `+
+			`WHERE n.nspname !~ '^pg_' AND n.nspname <> 'information_schema' AND c.relkind = $1 `+
+			`AND NOT EXISTS (SELECT 1 FROM pg_catalog.pg_depend dep WHERE dep.classid = 'pg_catalog.pg_class'::regclass AND dep.objid = c.oid AND dep.deptype = 'e') `+
+			`ORDER BY 1, 2`,
+		relkind,
+	)
+	if err != nil {
+		return fmt.Errorf("could not list views: %w", err)
+	}
+	views, err := scanNameRows(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, view := range views {
+		schemaName, viewName := view[0], view[1]
+		if err := discovery.addResource(resourceType, res, database+"_"+schemaName+"_"+viewName, func(d *schema.ResourceData) (string, error) {
+			d.Set(viewNameAttr, viewName)
+			d.Set(viewSchemaAttr, schemaName)
+			d.Set(viewDatabaseAttr, database)
+			return generateViewID(d, database), nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (discovery *importBlocksDiscovery) discoverPolicies(txn *sql.Tx, database string) error {
+	rows, err := txn.Query(
+		`SELECT n.nspname, c.relname, p.polname FROM pg_catalog.pg_policy p ` +
+			`JOIN pg_catalog.pg_class c ON c.oid = p.polrelid ` +
+			`JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace ` +
+			`WHERE n.nspname !~ '^pg_' AND n.nspname <> 'information_schema' ` +
+			`ORDER BY 1, 2, 3`,
+	)
+	if err != nil {
+		return fmt.Errorf("could not list policies: %w", err)
+	}
+	policies, err := scanNameRows(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		schemaName, tableName, policyName := policy[0], policy[1], policy[2]
+		if err := discovery.addResource("postgresql_policy", resourcePostgreSQLPolicy(), database+"_"+tableName+"_"+policyName, func(d *schema.ResourceData) (string, error) {
+			d.Set(policyNameAttr, policyName)
+			d.Set(policySchemaAttr, schemaName)
+			d.Set(policyTableAttr, tableName)
+			d.Set(policyDatabaseAttr, database)
+			return generatePolicyID(d, database), nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// defaultPrivilegesObjectTypes maps pg_default_acl.defaclobjtype to the object_type of postgresql_default_privileges.
+var defaultPrivilegesObjectTypes = map[string]string{
+	"r": "table",
+	"S": "sequence",
+	"f": "function",
+	"T": "type",
+	"n": "schema",
+	"L": "large_object",
+}
+
+func (discovery *importBlocksDiscovery) discoverDefaultPrivileges(txn *sql.Tx, database string) error {
+	// The privileges of the owner on its own objects are implicit.
+	rows, err := txn.Query(
+		`SELECT DISTINCT pg_catalog.pg_get_userbyid(a.defaclrole), COALESCE(n.nspname, ''), a.defaclobjtype::text, ` +
+			`COALESCE(r.rolname, 'public') ` +
+			`FROM pg_catalog.pg_default_acl a ` +
+			`LEFT JOIN pg_catalog.pg_namespace n ON n.oid = a.defaclnamespace ` +
+			`CROSS JOIN LATERAL pg_catalog.aclexplode(a.defaclacl) acl ` +
+			`LEFT JOIN pg_catalog.pg_roles r ON r.oid = acl.grantee ` +
+			`WHERE acl.grantee <> a.defaclrole ` +
+			`ORDER BY 1, 2, 3, 4`,
+	)
+	if err != nil {
+		return fmt.Errorf("could not list default privileges: %w", err)
+	}
+	privileges, err := scanNameRows(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, privilege := range privileges {
+		owner, schemaName, objectType, role := privilege[0], privilege[1], defaultPrivilegesObjectTypes[privilege[2]], privilege[3]
+		if objectType == "" {
+			continue
+		}
+		name := strings.Join([]string{role, database, schemaName, owner, objectType}, "_")
+		if err := discovery.addResource("postgresql_default_privileges", resourcePostgreSQLDefaultPrivileges(), name, func(d *schema.ResourceData) (string, error) {
+			d.Set("role", role)
+			d.Set("database", database)
+			d.Set("schema", schemaName)
+			d.Set("owner", owner)
+			d.Set("object_type", objectType)
+			return generateDefaultPrivilegesID(d), nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (discovery *importBlocksDiscovery) discoverGrantRoles() error {
+	rows, err := discovery.db.Query(
+		`SELECT DISTINCT r.rolname, g.rolname FROM pg_catalog.pg_auth_members m ` +
+			`JOIN pg_catalog.pg_roles r ON r.oid = m.member ` +
+			`JOIN pg_catalog.pg_roles g ON g.oid = m.roleid ` +
+			`WHERE r.rolname !~ '^pg_' ` +
+			`ORDER BY 1, 2`,
+	)
+	if err != nil {
+		return fmt.Errorf("could not list role memberships: %w", err)
+	}
+	memberships, err := scanNameRows(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, membership := range memberships {
+		role, grantRole := membership[0], membership[1]
+		if err := discovery.addResource("postgresql_grant_role", resourcePostgreSQLGrantRole(), role+"_"+grantRole, func(d *schema.ResourceData) (string, error) {
+			d.Set("role", role)
+			d.Set("grant_role", grantRole)
+			return generateGrantRoleID(d), nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (discovery *importBlocksDiscovery) discoverTablespaces() error {
+	tablespaces, err := queryNames(discovery.db, "SELECT spcname FROM pg_catalog.pg_tablespace WHERE spcname !~ '^pg_' ORDER BY spcname")
+	if err != nil {
+		return err
+	}
+
+	for _, tablespace := range tablespaces {
+		if err := discovery.addResource("postgresql_tablespace", resourcePostgreSQLTablespace(), tablespace, func(d *schema.ResourceData) (string, error) {
+			d.Set(tablespaceNameAttr, tablespace)
+			return tablespace, nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (discovery *importBlocksDiscovery) discoverPhysicalReplicationSlots() error {
+	slots, err := queryNames(discovery.db, "SELECT slot_name FROM pg_catalog.pg_replication_slots WHERE slot_type = 'physical' ORDER BY slot_name")
+	if err != nil {
+		return err
+	}
+
+	for _, slot := range slots {
+		if err := discovery.addResource("postgresql_physical_replication_slot", resourcePostgreSQLPhysicalReplicationSlot(), slot, func(d *schema.ResourceData) (string, error) {
+			d.Set("name", slot)
+			return slot, nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (discovery *importBlocksDiscovery) discoverRoleDatabaseSettings(databases []string) error {
+	rows, err := discovery.db.Query(
+		`SELECT d.datname, r.rolname FROM pg_catalog.pg_db_role_setting s `+
+			`JOIN pg_catalog.pg_database d ON d.oid = s.setdatabase `+
+			`JOIN pg_catalog.pg_roles r ON r.oid = s.setrole `+
+			`WHERE d.datname = ANY($1) `+
+			`ORDER BY 1, 2`,
+		pq.Array(databases),
+	)
+	if err != nil {
+		return fmt.Errorf("could not list role database settings: %w", err)
+	}
+	settings, err := scanNameRows(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, setting := range settings {
+		database, role := setting[0], setting[1]
+		if err := discovery.addResource("postgresql_role_database_settings", resourcePostgreSQLRoleDatabaseSettings(), database+"_"+role, func(d *schema.ResourceData) (string, error) {
+			d.Set(roleDBSettingsDatabaseAttr, database)
+			d.Set(roleDBSettingsRoleAttr, role)
+			return generateRoleDatabaseSettingsID(d), nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scanNameRows returns the values of all the (text) columns returned by the rows and closes them.
+func scanNameRows(rows *sql.Rows) ([][]string, error) {
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	var result [][]string
+	for rows.Next() {
+		values := make([]string, len(columns))
+		dest := make([]any, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("could not scan object names: %w", err)
+		}
+		result = append(result, values)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not list objects: %w", err)
+	}
+
+	return result, nil
+}
+
+func (discovery *importBlocksDiscovery) discoverServers() error {
+	if !discovery.db.featureSupported(featureServer) {
+		return nil
+	}
+
+	servers, err := queryNames(discovery.db, "SELECT srvname FROM pg_catalog.pg_foreign_server ORDER BY srvname")
+	if err != nil {
+		return err
+	}
+
+	for _, server := range servers {
+		if err := discovery.addResource("postgresql_server", resourcePostgreSQLServer(), server, func(d *schema.ResourceData) (string, error) {
+			d.Set(serverNameAttr, server)
+			return server, nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importGrant is a group of objects on which a role has the same privileges.
+type importGrant struct {
+	role       string
+	database   string
+	schema     string
+	objectType string
+	objects    []string
+}
+
+func (discovery *importBlocksDiscovery) discoverDatabaseGrants(databases []string) error {
+	// The privileges of the owner are implicit.
+	query := `SELECT d.datname, COALESCE(r.rolname, 'public'), a.privilege_type ` +
+		`FROM pg_catalog.pg_database d CROSS JOIN LATERAL pg_catalog.aclexplode(d.datacl) a ` +
+		`LEFT JOIN pg_catalog.pg_roles r ON r.oid = a.grantee ` +
+		`WHERE d.datname = ANY($1) AND a.grantee <> d.datdba ` +
+		`ORDER BY 1, 2, 3`
+
+	return discovery.addGrants(discovery.db, query, []any{pq.Array(databases)}, func(values []string) importGrant {
+		return importGrant{role: values[1], database: values[0], objectType: "database"}
+	})
+}
+
+func (discovery *importBlocksDiscovery) discoverSchemaObjectGrants(database string) error {
+	txn, err := startTransaction(discovery.db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	query := `SELECT n.nspname, COALESCE(r.rolname, 'public'), a.privilege_type ` +
+		`FROM pg_catalog.pg_namespace n CROSS JOIN LATERAL pg_catalog.aclexplode(n.nspacl) a ` +
+		`LEFT JOIN pg_catalog.pg_roles r ON r.oid = a.grantee ` +
+		`WHERE n.nspname !~ '^pg_' AND n.nspname <> 'information_schema' AND a.grantee <> n.nspowner ` +
+		`ORDER BY 1, 2, 3`
+	if err := discovery.addGrants(txn, query, nil, func(values []string) importGrant {
+		return importGrant{role: values[1], database: database, schema: values[0], objectType: "schema"}
+	}); err != nil {
+		return err
+	}
+
+	query = `SELECT n.nspname, c.relkind::text, c.relname, COALESCE(r.rolname, 'public'), a.privilege_type ` +
+		`FROM pg_catalog.pg_class c JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace ` +
+		`CROSS JOIN LATERAL pg_catalog.aclexplode(c.relacl) a ` +
+		`LEFT JOIN pg_catalog.pg_roles r ON r.oid = a.grantee ` +
+		`WHERE n.nspname !~ '^pg_' AND n.nspname <> 'information_schema' AND c.relkind IN ('r', 'S') AND a.grantee <> c.relowner ` +
+		`ORDER BY 1, 2, 3, 4, 5`
+	if err := discovery.addGrants(txn, query, nil, func(values []string) importGrant {
+		objectType := "table"
+		if values[1] == objectTypes["sequence"] {
+			objectType = "sequence"
+		}
+		return importGrant{role: values[3], database: database, schema: values[0], objectType: objectType, objects: []string{values[2]}}
+	}); err != nil {
+		return err
+	}
+
+	// The privileges are read per function name by postgresql_grant, so the overloaded functions are merged.
+	kindColumn := "'function'"
+	if discovery.db.featureSupported(featureProcedure) {
+		kindColumn = "CASE WHEN p.prokind = 'p' THEN 'procedure' ELSE 'function' END"
+	}
+	query = `SELECT DISTINCT n.nspname, ` + kindColumn + `, p.proname, COALESCE(r.rolname, 'public'), a.privilege_type ` +
+		`FROM pg_catalog.pg_proc p JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace ` +
+		`CROSS JOIN LATERAL pg_catalog.aclexplode(p.proacl) a ` +
+		`LEFT JOIN pg_catalog.pg_roles r ON r.oid = a.grantee ` +
+		`WHERE n.nspname !~ '^pg_' AND n.nspname <> 'information_schema' AND a.grantee <> p.proowner ` +
+		`ORDER BY 1, 2, 3, 4, 5`
+	if err := discovery.addGrants(txn, query, nil, func(values []string) importGrant {
+		return importGrant{role: values[3], database: database, schema: values[0], objectType: values[1], objects: []string{values[2]}}
+	}); err != nil {
+		return err
+	}
+
+	query = `SELECT n.nspname, t.typname, COALESCE(r.rolname, 'public'), a.privilege_type ` +
+		`FROM pg_catalog.pg_type t JOIN pg_catalog.pg_namespace n ON n.oid = t.typnamespace ` +
+		`CROSS JOIN LATERAL pg_catalog.aclexplode(t.typacl) a ` +
+		`LEFT JOIN pg_catalog.pg_roles r ON r.oid = a.grantee ` +
+		`WHERE n.nspname !~ '^pg_' AND n.nspname <> 'information_schema' AND a.grantee <> t.typowner ` +
+		`ORDER BY 1, 2, 3, 4`
+	if err := discovery.addGrants(txn, query, nil, func(values []string) importGrant {
+		return importGrant{role: values[2], database: database, schema: values[0], objectType: "type", objects: []string{values[1]}}
+	}); err != nil {
+		return err
+	}
+
+	query = `SELECT w.fdwname, COALESCE(r.rolname, 'public'), a.privilege_type ` +
+		`FROM pg_catalog.pg_foreign_data_wrapper w CROSS JOIN LATERAL pg_catalog.aclexplode(w.fdwacl) a ` +
+		`LEFT JOIN pg_catalog.pg_roles r ON r.oid = a.grantee ` +
+		`WHERE a.grantee <> w.fdwowner ` +
+		`ORDER BY 1, 2, 3`
+	if err := discovery.addGrants(txn, query, nil, func(values []string) importGrant {
+		return importGrant{role: values[1], database: database, objectType: "foreign_data_wrapper", objects: []string{values[0]}}
+	}); err != nil {
+		return err
+	}
+
+	query = `SELECT s.srvname, COALESCE(r.rolname, 'public'), a.privilege_type ` +
+		`FROM pg_catalog.pg_foreign_server s CROSS JOIN LATERAL pg_catalog.aclexplode(s.srvacl) a ` +
+		`LEFT JOIN pg_catalog.pg_roles r ON r.oid = a.grantee ` +
+		`WHERE a.grantee <> s.srvowner ` +
+		`ORDER BY 1, 2, 3`
+	return discovery.addGrants(txn, query, nil, func(values []string) importGrant {
+		return importGrant{role: values[1], database: database, objectType: "foreign_server", objects: []string{values[0]}}
+	})
+}
+
+// discoverTablespaceGrants discovers the privileges on the tablespaces, which are shared by all the databases,
+// so they are managed in the provider database.
+func (discovery *importBlocksDiscovery) discoverTablespaceGrants() error {
+	query := `SELECT t.spcname, COALESCE(r.rolname, 'public'), a.privilege_type ` +
+		`FROM pg_catalog.pg_tablespace t CROSS JOIN LATERAL pg_catalog.aclexplode(t.spcacl) a ` +
+		`LEFT JOIN pg_catalog.pg_roles r ON r.oid = a.grantee ` +
+		`WHERE t.spcname !~ '^pg_' AND a.grantee <> t.spcowner ` +
+		`ORDER BY 1, 2, 3`
+
+	return discovery.addGrants(discovery.db, query, nil, func(values []string) importGrant {
+		return importGrant{role: values[1], database: discovery.db.client.databaseName, objectType: "tablespace", objects: []string{values[0]}}
+	})
+}
+
+// importGrantSingleObjectTypes are the object types for which a postgresql_grant has exactly one object.
+var importGrantSingleObjectTypes = []string{"foreign_data_wrapper", "foreign_server", "tablespace"}
+
+// importGrantPrivilege is a privilege of a role on an object, as read from the catalog.
+type importGrantPrivilege struct {
+	grant     importGrant
+	privilege string
+}
+
+// addGrants adds a postgresql_grant for each group of objects having the same privileges for the same role.
+// The query has to return the privilege type as last column, toGrant builds the grant from the other columns.
+func (discovery *importBlocksDiscovery) addGrants(db QueryAble, query string, args []any, toGrant func([]string) importGrant) error {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return fmt.Errorf("could not list privileges: %w", err)
+	}
+	defer rows.Close()
+
+	var privileges []importGrantPrivilege
+	for rows.Next() {
+		columns, err := rows.Columns()
+		if err != nil {
+			return err
+		}
+		values := make([]string, len(columns))
+		dest := make([]any, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return fmt.Errorf("could not scan privilege: %w", err)
+		}
+
+		privileges = append(privileges, importGrantPrivilege{
+			grant:     toGrant(values[:len(values)-1]),
+			privilege: values[len(values)-1],
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("could not list privileges: %w", err)
+	}
+
+	groups := groupImportGrants(privileges)
+
+	for _, grant := range groups {
+		name := strings.Join([]string{grant.role, grant.database, grant.schema, grant.objectType}, "_")
+		if err := discovery.addResource("postgresql_grant", resourcePostgreSQLGrant(), name, func(d *schema.ResourceData) (string, error) {
+			objects := []any{}
+			for _, object := range grant.objects {
+				objects = append(objects, object)
+			}
+
+			d.Set("role", grant.role)
+			d.Set("database", grant.database)
+			d.Set("schema", grant.schema)
+			d.Set("object_type", grant.objectType)
+			d.Set("objects", schema.NewSet(schema.HashString, objects))
+			return generateGrantID(d), nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importGrantKey identifies the objects of a grant for a role.
+func importGrantKey(grant importGrant) string {
+	return strings.Join(append([]string{grant.role, grant.database, grant.schema, grant.objectType}, grant.objects...), ".")
+}
+
+// groupImportGrants aggregates the privileges per object and role, then groups the objects
+// of the same schema having the same privileges for the same role.
+func groupImportGrants(rows []importGrantPrivilege) []importGrant {
+	var grants []importGrant
+	privileges := map[string][]string{}
+	for _, row := range rows {
+		key := importGrantKey(row.grant)
+		if _, ok := privileges[key]; !ok {
+			grants = append(grants, row.grant)
+		}
+		privileges[key] = append(privileges[key], row.privilege)
+	}
+
+	var groups []importGrant
+	groupIndex := map[string]int{}
+	for _, grant := range grants {
+		objectPrivileges := privileges[importGrantKey(grant)]
+		key := strings.Join([]string{grant.role, grant.database, grant.schema, grant.objectType, strings.Join(objectPrivileges, ",")}, ".")
+		// postgresql_grant manages only one foreign data wrapper, foreign server or tablespace.
+		if i, ok := groupIndex[key]; ok && len(grant.objects) > 0 && !sliceContainsStr(importGrantSingleObjectTypes, grant.objectType) {
+			groups[i].objects = append(groups[i].objects, grant.objects...)
+			continue
+		}
+		groupIndex[key] = len(groups)
+		groups = append(groups, grant)
+	}
+
+	return groups
+}
+
+// addResource reads the object with the resource Read function, as done after an import,
+// and renders its configuration. setID sets the attributes identifying the object and returns its ID.
+func (discovery *importBlocksDiscovery) addResource(resourceType string, res *schema.Resource, name string, setID func(*schema.ResourceData) (string, error)) error {
+	d := res.Data(nil)
+	id, err := setID(d)
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	if err := res.Read(d, discovery.db.client); err != nil {
+		return fmt.Errorf("could not read %s %s: %w", resourceType, id, err)
+	}
+	if d.Id() == "" {
+		log.Printf("[WARN] %s %s disappeared during the discovery", resourceType, id)
+		return nil
+	}
+
+	name = discovery.terraformName(resourceType, name)
+	discovery.blocks = append(discovery.blocks, importBlock{
+		resourceType: resourceType,
+		name:         name,
+		id:           d.Id(),
+		config:       renderResourceConfig(resourceType, name, res.Schema, d),
+	})
+
+	return nil
+}
+
+var terraformNameInvalidChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// terraformName returns a valid Terraform name, unique for the resource type, for an object name.
+func (discovery *importBlocksDiscovery) terraformName(resourceType, name string) string {
+	name = strings.Trim(terraformNameInvalidChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+
+	if discovery.usedNames[resourceType] == nil {
+		discovery.usedNames[resourceType] = map[string]bool{}
+	}
+	used := discovery.usedNames[resourceType]
+
+	uniqueName := name
+	for i := 2; used[uniqueName]; i++ {
+		uniqueName = fmt.Sprintf("%s_%d", name, i)
+	}
+	used[uniqueName] = true
+
+	return uniqueName
+}
+
+// renderResourceConfig renders the HCL configuration of a resource from its state.
+// The computed only, deprecated and unset attributes and the attributes having their default value are skipped.
+func renderResourceConfig(resourceType, name string, resourceSchema map[string]*schema.Schema, d *schema.ResourceData) string {
+	b := bytes.NewBufferString("")
+	fmt.Fprintf(b, "resource %q %q {\n", resourceType, name)
+	renderHCLBody(b, "  ", resourceSchema, func(attr string) any { return d.Get(attr) })
+	fmt.Fprint(b, "}\n")
+	return b.String()
+}
+
+func renderHCLBody(b *bytes.Buffer, indent string, attrsSchema map[string]*schema.Schema, get func(string) any) {
+	// Required attributes (e.g.: name) first then the optional ones.
+	attrs := make([]string, 0, len(attrsSchema))
+	for attr := range attrsSchema {
+		attrs = append(attrs, attr)
+	}
+	sort.Slice(attrs, func(i, j int) bool {
+		if attrsSchema[attrs[i]].Required != attrsSchema[attrs[j]].Required {
+			return attrsSchema[attrs[i]].Required
+		}
+		return attrs[i] < attrs[j]
+	})
+
+	type hclAttribute struct {
+		name  string
+		value string
+	}
+	var values []hclAttribute
+	var blocks []string
+
+	for _, attr := range attrs {
+		s := attrsSchema[attr]
+		if s.Deprecated != "" || (s.Computed && !s.Optional && !s.Required) {
+			continue
+		}
+
+		value := get(attr)
+		if !s.Required && isDefaultHCLValue(s, value) {
+			continue
+		}
+
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			var items []any
+			switch v := value.(type) {
+			case *schema.Set:
+				items = v.List()
+			case []any:
+				items = v
+			}
+			for _, item := range items {
+				block := bytes.NewBufferString("")
+				fmt.Fprintf(block, "%s%s {\n", indent, attr)
+				itemValues := item.(map[string]any)
+				renderHCLBody(block, indent+"  ", elem.Schema, func(attr string) any { return itemValues[attr] })
+				fmt.Fprintf(block, "%s}\n", indent)
+				blocks = append(blocks, block.String())
+			}
+			continue
+		}
+
+		values = append(values, hclAttribute{name: attr, value: renderHCLValue(value, indent)})
+	}
+
+	// Align the equal signs like terraform fmt does: a multi-line value ends the alignment group.
+	for start := 0; start < len(values); {
+		end := start
+		for end < len(values)-1 && !strings.Contains(values[end].value, "\n") {
+			end++
+		}
+
+		width := 0
+		for _, v := range values[start : end+1] {
+			width = max(width, len(v.name))
+		}
+		for _, v := range values[start : end+1] {
+			fmt.Fprintf(b, "%s%-*s = %s\n", indent, width, v.name, v.value)
+		}
+		start = end + 1
+	}
+	for _, block := range blocks {
+		fmt.Fprint(b, block)
+	}
+}
+
+// isDefaultHCLValue returns true if the value is the default or the zero value of the attribute.
+func isDefaultHCLValue(s *schema.Schema, value any) bool {
+	if s.Default != nil {
+		return value == s.Default
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case *schema.Set:
+		return v.Len() == 0
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
+
+func renderHCLValue(value any, indent string) string {
+	switch v := value.(type) {
+	case string:
+		return hclQuote(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case *schema.Set:
+		// Sets are unordered, their items are sorted to get a stable output.
+		items := renderHCLItems(v.List(), indent)
+		sort.Strings(items)
+		return "[" + strings.Join(items, ", ") + "]"
+	case []any:
+		// The order of a list is meaningful (e.g.: search_path), it is kept.
+		return "[" + strings.Join(renderHCLItems(v, indent), ", ") + "]"
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		width := 0
+		for k := range v {
+			keys = append(keys, k)
+			width = max(width, len(hclQuote(k)))
+		}
+		sort.Strings(keys)
+
+		b := bytes.NewBufferString("{\n")
+		for _, k := range keys {
+			fmt.Fprintf(b, "%s  %-*s = %s\n", indent, width, hclQuote(k), renderHCLValue(v[k], indent+"  "))
+		}
+		fmt.Fprintf(b, "%s}", indent)
+		return b.String()
+	}
+
+	return hclQuote(fmt.Sprint(value))
+}
+
+func renderHCLItems(values []any, indent string) []string {
+	items := make([]string, 0, len(values))
+	for _, item := range values {
+		items = append(items, renderHCLValue(item, indent))
+	}
+	return items
+}
+
+var hclStringEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+	"${", "$${",
+	"%{", "%%{",
+)
+
+// hclQuote returns a quoted HCL string, escaping the template sequences.
+func hclQuote(value string) string {
+	return `"` + hclStringEscaper.Replace(value) + `"`
+}
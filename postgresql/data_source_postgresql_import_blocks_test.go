@@ -0,0 +1,169 @@
+package postgresql
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccPostgresqlDataSourceImportBlocks(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	createTestSchemas(t, dbSuffix, []string{"test_schema"}, "")
+	createTestTables(t, dbSuffix, []string{"test_schema.test_table", "test_schema.test_table2"}, "")
+
+	dbName, roleName := getTestDBNames(dbSuffix)
+	config := getTestConfig(t)
+	dbExecute(t, config.connStr(dbName), fmt.Sprintf("GRANT SELECT ON ALL TABLES IN SCHEMA test_schema TO %s", roleName))
+	dbExecute(t, config.connStr(dbName), "ALTER TABLE test_schema.test_table ENABLE ROW LEVEL SECURITY")
+	dbExecute(t, config.connStr(dbName), "CREATE FUNCTION test_schema.test_func() RETURNS integer LANGUAGE sql AS 'SELECT 1'")
+	dbExecute(t, config.connStr(dbName), fmt.Sprintf("GRANT EXECUTE ON FUNCTION test_schema.test_func() TO %s", roleName))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featurePrivileges)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "postgresql_import_blocks" "test" {
+	databases      = ["%s"]
+	resource_types = ["postgresql_schema", "postgresql_grant", "postgresql_table", "postgresql_row_level_security"]
+}
+`, dbName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("data.postgresql_import_blocks.test", "content", func(content string) error {
+						expected := []string{
+							fmt.Sprintf(`id = "%s_test_schema"`, dbName),
+							`resource "postgresql_schema" "` + dbName + `_test_schema" {`,
+							// Both tables have the same privileges so they are grouped in the same grant.
+							fmt.Sprintf(`id = "%s_%s_test_schema_table_test_table_test_table2"`, roleName, dbName),
+							`objects     = ["test_table", "test_table2"]`,
+							`privileges  = ["SELECT"]`,
+							fmt.Sprintf(`id = "%s_%s_test_schema_function_test_func"`, roleName, dbName),
+							fmt.Sprintf(`id = "%s.test_schema.test_table"`, dbName),
+							`resource "postgresql_table" "` + dbName + `_test_schema_test_table2" {`,
+							`resource "postgresql_row_level_security" "` + dbName + `_test_schema_test_table" {`,
+						}
+						for _, e := range expected {
+							if !strings.Contains(content, e) {
+								return fmt.Errorf("expected %q in generated content:\n%s", e, content)
+							}
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestRenderResourceConfig(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"name":        {Type: schema.TypeString, Required: true},
+		"owner":       {Type: schema.TypeString, Optional: true, Computed: true},
+		"comment":     {Type: schema.TypeString, Optional: true},
+		"enabled":     {Type: schema.TypeBool, Optional: true, Default: true},
+		"limit":       {Type: schema.TypeInt, Optional: true, Default: -1},
+		"oid":         {Type: schema.TypeInt, Computed: true},
+		"policy":      {Type: schema.TypeString, Optional: true, Deprecated: "deprecated"},
+		"roles":       {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}, Set: schema.HashString},
+		"parameters":  {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"search_path": {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"arg": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {Type: schema.TypeString, Required: true},
+					"mode": {Type: schema.TypeString, Optional: true, Default: "IN"},
+				},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]any{
+		"name":        "my ${name}",
+		"owner":       "admin",
+		"enabled":     false,
+		"limit":       -1,
+		"policy":      "skipped",
+		"roles":       []any{"b", "a"},
+		"search_path": []any{"z_schema", "public"},
+		"parameters":  map[string]any{"work_mem": "4MB", "search_path": `"$user", public`},
+		"arg": []any{
+			map[string]any{"type": "integer"},
+			map[string]any{"type": "text", "mode": "OUT"},
+		},
+	})
+
+	assert.Equal(t, `resource "postgresql_test" "my_name" {
+  name       = "my $${name}"
+  enabled    = false
+  owner      = "admin"
+  parameters = {
+    "search_path" = "\"$user\", public"
+    "work_mem"    = "4MB"
+  }
+  roles       = ["a", "b"]
+  search_path = ["z_schema", "public"]
+  arg {
+    type = "integer"
+  }
+  arg {
+    type = "text"
+    mode = "OUT"
+  }
+}
+`, renderResourceConfig("postgresql_test", "my_name", resourceSchema, d))
+}
+
+func TestHCLQuote(t *testing.T) {
+	assert.Equal(t, `"simple"`, hclQuote("simple"))
+	assert.Equal(t, `"a \"quoted\" \\ value"`, hclQuote(`a "quoted" \ value`))
+	assert.Equal(t, `"line1\nline2\ttab\r"`, hclQuote("line1\nline2\ttab\r"))
+	assert.Equal(t, `"$${var} %%{ if } $ %"`, hclQuote("${var} %{ if } $ %"))
+}
+
+func TestImportBlocksTerraformName(t *testing.T) {
+	discovery := &importBlocksDiscovery{usedNames: map[string]map[string]bool{}}
+
+	assert.Equal(t, "my_role", discovery.terraformName("postgresql_role", "My Role"))
+	assert.Equal(t, "my_role_2", discovery.terraformName("postgresql_role", "my-role"))
+	assert.Equal(t, "my_role", discovery.terraformName("postgresql_database", "my_role"))
+	assert.Equal(t, "_1role", discovery.terraformName("postgresql_role", "1role"))
+	assert.Equal(t, "_", discovery.terraformName("postgresql_role", "éé"))
+	assert.Equal(t, "db_public", discovery.terraformName("postgresql_schema", "db_public"))
+}
+
+func TestGroupImportGrants(t *testing.T) {
+	grants := groupImportGrants([]importGrantPrivilege{
+		{grant: importGrant{role: "app", database: "db1", objectType: "database"}, privilege: "CONNECT"},
+		{grant: importGrant{role: "app", database: "db2", objectType: "database"}, privilege: "CONNECT"},
+		{grant: importGrant{role: "app", database: "db2", objectType: "database"}, privilege: "TEMPORARY"},
+		{grant: importGrant{role: "app", database: "db1", schema: "s", objectType: "table", objects: []string{"t1"}}, privilege: "SELECT"},
+		{grant: importGrant{role: "app", database: "db1", schema: "s", objectType: "table", objects: []string{"t2"}}, privilege: "SELECT"},
+		{grant: importGrant{role: "app", database: "db2", schema: "s", objectType: "table", objects: []string{"t1"}}, privilege: "SELECT"},
+		{grant: importGrant{role: "app", database: "db1", objectType: "foreign_server", objects: []string{"srv1"}}, privilege: "USAGE"},
+		{grant: importGrant{role: "app", database: "db1", objectType: "foreign_server", objects: []string{"srv2"}}, privilege: "USAGE"},
+	})
+
+	assert.Equal(t, []importGrant{
+		{role: "app", database: "db1", objectType: "database"},
+		{role: "app", database: "db2", objectType: "database"},
+		{role: "app", database: "db1", schema: "s", objectType: "table", objects: []string{"t1", "t2"}},
+		{role: "app", database: "db2", schema: "s", objectType: "table", objects: []string{"t1"}},
+		// A grant manages only one foreign server.
+		{role: "app", database: "db1", objectType: "foreign_server", objects: []string{"srv1"}},
+		{role: "app", database: "db1", objectType: "foreign_server", objects: []string{"srv2"}},
+	}, grants)
+}
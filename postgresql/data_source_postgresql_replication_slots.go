@@ -0,0 +1,212 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// The current WAL location is not available on a standby, the last received one is used instead.
+	currentWALLSNQuery = "CASE WHEN pg_catalog.pg_is_in_recovery() THEN pg_catalog.pg_last_wal_receive_lsn() ELSE pg_catalog.pg_current_wal_lsn() END"
+
+	replicationSlotsQuery = `
+	SELECT slot_name, slot_type, plugin, database, active, active_pid, restart_lsn, confirmed_flush_lsn, wal_status, retained_bytes
+	FROM (
+		SELECT slot_name::text, slot_type, COALESCE(plugin::text, '') AS plugin, COALESCE(database::text, '') AS database,
+		active, active_pid, restart_lsn::text, confirmed_flush_lsn::text, %s AS wal_status,
+		pg_catalog.pg_wal_lsn_diff(` + currentWALLSNQuery + `, restart_lsn)::bigint AS retained_bytes
+		FROM pg_catalog.pg_replication_slots
+	) slots
+	`
+	replicationSlotPatternMatchingTarget = "slot_name"
+	replicationSlotTypeKeyword           = "slot_type"
+	replicationSlotDatabaseKeyword       = "database"
+)
+
+func dataSourcePostgreSQLReplicationSlots() *schema.Resource {
+	return &schema.Resource{
+		Read: PGResourceFunc(dataSourcePostgreSQLReplicationSlotsRead),
+		Schema: map[string]*schema.Schema{
+			"slot_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The type of the replication slots to retrieve (physical or logical). Retrieves both by default",
+				ValidateFunc: validation.StringInSlice([]string{"physical", "logical"}, false),
+			},
+			"databases": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				MinItems:    0,
+				Description: "The databases of the logical replication slots to retrieve. Retrieves the slots of all the databases by default",
+			},
+			"like_any_patterns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				MinItems:    0,
+				Description: "Expression(s) which will be pattern matched against slot names in the query using the PostgreSQL LIKE ANY operator",
+			},
+			"like_all_patterns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				MinItems:    0,
+				Description: "Expression(s) which will be pattern matched against slot names in the query using the PostgreSQL LIKE ALL operator",
+			},
+			"not_like_all_patterns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				MinItems:    0,
+				Description: "Expression(s) which will be pattern matched against slot names in the query using the PostgreSQL NOT LIKE ALL operator",
+			},
+			"regex_pattern": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Expression which will be pattern matched against slot names in the query using the PostgreSQL ~ (regular expression match) operator",
+			},
+			"replication_slots": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slot_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slot_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"plugin": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"database": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"active_pid": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"restart_lsn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"confirmed_flush_lsn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"wal_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"retained_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+				Description: "The list of replication slots retrieved by this data source",
+			},
+		},
+	}
+}
+
+func dataSourcePostgreSQLReplicationSlotsRead(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureWALFunctions) {
+		return fmt.Errorf(
+			"postgresql_replication_slots data source is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	walStatusColumn := "NULL::text"
+	if db.featureSupported(featureReplicationSlotWALStatus) {
+		walStatusColumn = "wal_status"
+	}
+
+	query := fmt.Sprintf(replicationSlotsQuery, walStatusColumn)
+	query = applyReplicationSlotsDataSourceQueryFilters(query, queryConcatKeywordWhere, d)
+	query += " ORDER BY slot_name"
+
+	rows, err := db.Query(query)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("error closing rows: %v", err)
+		}
+	}()
+
+	slots := make([]any, 0)
+	for rows.Next() {
+		var slotName, slotType, plugin, database string
+		var active bool
+		var activePID, retainedBytes sql.NullInt64
+		var restartLSN, confirmedFlushLSN, walStatus sql.NullString
+
+		if err = rows.Scan(&slotName, &slotType, &plugin, &database, &active, &activePID, &restartLSN, &confirmedFlushLSN, &walStatus, &retainedBytes); err != nil {
+			return fmt.Errorf("could not scan replication slot output: %w", err)
+		}
+
+		result := make(map[string]any)
+		result["slot_name"] = slotName
+		result["slot_type"] = slotType
+		result["plugin"] = plugin
+		result["database"] = database
+		result["active"] = active
+		result["active_pid"] = int(activePID.Int64)
+		result["restart_lsn"] = restartLSN.String
+		result["confirmed_flush_lsn"] = confirmedFlushLSN.String
+		result["wal_status"] = walStatus.String
+		result["retained_bytes"] = int(retainedBytes.Int64)
+		slots = append(slots, result)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("could not read replication slots: %w", err)
+	}
+
+	d.Set("replication_slots", slots)
+	d.SetId(generateDataSourceReplicationSlotsID(d))
+
+	return nil
+}
+
+func generateDataSourceReplicationSlotsID(d *schema.ResourceData) string {
+	return strings.Join([]string{
+		"replication_slots",
+		d.Get("slot_type").(string),
+		generatePatternArrayString(d.Get("databases").([]any), queryArrayKeywordAny),
+		generatePatternArrayString(d.Get("like_any_patterns").([]any), queryArrayKeywordAny),
+		generatePatternArrayString(d.Get("like_all_patterns").([]any), queryArrayKeywordAll),
+		generatePatternArrayString(d.Get("not_like_all_patterns").([]any), queryArrayKeywordAll),
+		d.Get("regex_pattern").(string),
+	}, "_")
+}
+
+func applyReplicationSlotsDataSourceQueryFilters(query string, queryConcatKeyword string, d *schema.ResourceData) string {
+	filters := []string{}
+	if slotType := d.Get("slot_type").(string); slotType != "" {
+		filters = append(filters, applyTypeMatchingToQuery(replicationSlotTypeKeyword, []any{slotType}))
+	}
+	databasesTypeFilter := applyTypeMatchingToQuery(replicationSlotDatabaseKeyword, d.Get("databases").([]any))
+	if len(databasesTypeFilter) > 0 {
+		filters = append(filters, databasesTypeFilter)
+	}
+	filters = append(filters, applyPatternMatchingToQuery(replicationSlotPatternMatchingTarget, d)...)
+
+	return finalizeQueryWithFilters(query, queryConcatKeyword, filters)
+}
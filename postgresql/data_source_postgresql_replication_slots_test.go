@@ -0,0 +1,89 @@
+package postgresql
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPostgresqlDataSourceReplicationSlots(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testSuperuserPreCheck(t)
+			testCheckCompatibleVersion(t, featureWALFunctions)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlPhysicalReplicationSlotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPostgresqlDataSourceReplicationSlotsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.postgresql_replication_slots.like_any", "replication_slots.#", "2"),
+					resource.TestCheckResourceAttr("data.postgresql_replication_slots.like_any", "replication_slots.0.slot_name", "tf_tests_ds_slot1"),
+					resource.TestCheckResourceAttr("data.postgresql_replication_slots.like_any", "replication_slots.0.slot_type", "physical"),
+					resource.TestCheckResourceAttr("data.postgresql_replication_slots.like_any", "replication_slots.0.plugin", ""),
+					resource.TestCheckResourceAttr("data.postgresql_replication_slots.like_any", "replication_slots.0.active", "false"),
+					resource.TestCheckResourceAttrSet("data.postgresql_replication_slots.like_any", "replication_slots.0.restart_lsn"),
+					resource.TestCheckResourceAttrSet("data.postgresql_replication_slots.like_any", "replication_slots.0.retained_bytes"),
+					resource.TestCheckResourceAttr("data.postgresql_replication_slots.like_any", "replication_slots.1.slot_name", "tf_tests_ds_slot2"),
+					resource.TestCheckResourceAttr("data.postgresql_replication_slots.regex", "replication_slots.#", "1"),
+					resource.TestCheckResourceAttr("data.postgresql_replication_slots.regex", "replication_slots.0.slot_name", "tf_tests_ds_slot2"),
+					resource.TestCheckResourceAttr("data.postgresql_replication_slots.not_like_all", "replication_slots.#", "1"),
+					resource.TestCheckResourceAttr("data.postgresql_replication_slots.not_like_all", "replication_slots.0.slot_name", "tf_tests_ds_slot1"),
+					resource.TestCheckResourceAttr("data.postgresql_replication_slots.logical", "replication_slots.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+var testAccPostgresqlDataSourceReplicationSlotsConfig = `
+resource "postgresql_physical_replication_slot" "slot1" {
+	name                = "tf_tests_ds_slot1"
+	immediately_reserve = true
+}
+
+resource "postgresql_physical_replication_slot" "slot2" {
+	name                = "tf_tests_ds_slot2"
+	immediately_reserve = true
+}
+
+data "postgresql_replication_slots" "like_any" {
+	like_any_patterns = ["tf_tests_ds_slot%"]
+
+	depends_on = [
+		postgresql_physical_replication_slot.slot1,
+		postgresql_physical_replication_slot.slot2,
+	]
+}
+
+data "postgresql_replication_slots" "regex" {
+	regex_pattern = "^tf_tests_ds_slot2$"
+
+	depends_on = [
+		postgresql_physical_replication_slot.slot1,
+		postgresql_physical_replication_slot.slot2,
+	]
+}
+
+data "postgresql_replication_slots" "not_like_all" {
+	like_any_patterns     = ["tf_tests_ds_slot%"]
+	not_like_all_patterns = ["%2"]
+
+	depends_on = [
+		postgresql_physical_replication_slot.slot1,
+		postgresql_physical_replication_slot.slot2,
+	]
+}
+
+data "postgresql_replication_slots" "logical" {
+	slot_type         = "logical"
+	like_any_patterns = ["tf_tests_ds_slot%"]
+
+	depends_on = [
+		postgresql_physical_replication_slot.slot1,
+		postgresql_physical_replication_slot.slot2,
+	]
+}
+`
@@ -0,0 +1,334 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	replicationStatusQuery = `
+	SELECT pid, application_name, client_addr, state, sync_state, slot_name, sent_lsn, write_lsn, flush_lsn, replay_lsn,
+	write_lag, flush_lag, replay_lag, replay_lag_bytes
+	FROM (
+		SELECT r.pid, r.application_name, COALESCE(host(r.client_addr), '') AS client_addr, r.state, r.sync_state,
+		COALESCE(s.slot_name::text, '') AS slot_name,
+		r.sent_lsn::text, r.write_lsn::text, r.flush_lsn::text, r.replay_lsn::text,
+		EXTRACT(EPOCH FROM r.write_lag)::float8 AS write_lag,
+		EXTRACT(EPOCH FROM r.flush_lag)::float8 AS flush_lag,
+		EXTRACT(EPOCH FROM r.replay_lag)::float8 AS replay_lag,
+		pg_catalog.pg_wal_lsn_diff(` + currentWALLSNQuery + `, r.replay_lsn)::bigint AS replay_lag_bytes
+		FROM pg_catalog.pg_stat_replication r
+		LEFT JOIN pg_catalog.pg_replication_slots s ON s.active_pid = r.pid
+	) replications
+	`
+
+	// Only the apply worker of each subscription is listed, table synchronization workers have a relid
+	// and parallel apply workers (PG16+) have a leader_pid.
+	subscriptionStatusQuery = `
+	SELECT subscription_name, pid, slot_name, received_lsn, latest_end_lsn, last_msg_send_time, last_msg_receipt_time,
+	latest_end_time, receipt_lag
+	FROM (
+		SELECT ss.subname::text AS subscription_name, ss.pid, COALESCE(sub.subslotname::text, '') AS slot_name,
+		ss.received_lsn::text, ss.latest_end_lsn::text,
+		ss.last_msg_send_time::text, ss.last_msg_receipt_time::text, ss.latest_end_time::text,
+		EXTRACT(EPOCH FROM (ss.last_msg_receipt_time - ss.last_msg_send_time))::float8 AS receipt_lag
+		FROM pg_catalog.pg_stat_subscription ss
+		JOIN pg_catalog.pg_subscription sub ON sub.oid = ss.subid
+		WHERE ss.relid IS NULL %s
+	) subscriptions
+	`
+	replicationStatusPatternMatchingTarget = "slot_name"
+)
+
+func dataSourcePostgreSQLReplicationStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: PGResourceFunc(dataSourcePostgreSQLReplicationStatusRead),
+		Schema: map[string]*schema.Schema{
+			"like_any_patterns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				MinItems:    0,
+				Description: "Expression(s) which will be pattern matched against replication slot names in the query using the PostgreSQL LIKE ANY operator",
+			},
+			"like_all_patterns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				MinItems:    0,
+				Description: "Expression(s) which will be pattern matched against replication slot names in the query using the PostgreSQL LIKE ALL operator",
+			},
+			"not_like_all_patterns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				MinItems:    0,
+				Description: "Expression(s) which will be pattern matched against replication slot names in the query using the PostgreSQL NOT LIKE ALL operator",
+			},
+			"regex_pattern": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Expression which will be pattern matched against replication slot names in the query using the PostgreSQL ~ (regular expression match) operator",
+			},
+			"replications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pid": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"application_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_addr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sync_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slot_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sent_lsn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"write_lsn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"flush_lsn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replay_lsn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"write_lag": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"flush_lag": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"replay_lag": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"replay_lag_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+				Description: "The WAL senders of the server (from pg_stat_replication)",
+			},
+			"subscriptions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subscription_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pid": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"slot_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"received_lsn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latest_end_lsn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_msg_send_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_msg_receipt_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latest_end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"receipt_lag": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+				Description: "The apply workers of the subscriptions (from pg_stat_subscription)",
+			},
+		},
+	}
+}
+
+func dataSourcePostgreSQLReplicationStatusRead(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureWALFunctions) {
+		return fmt.Errorf(
+			"postgresql_replication_status data source is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	filters := applyPatternMatchingToQuery(replicationStatusPatternMatchingTarget, d)
+
+	replications, err := readReplicationStatus(db, filters)
+	if err != nil {
+		return err
+	}
+
+	subscriptions, err := readSubscriptionStatus(db, filters)
+	if err != nil {
+		return err
+	}
+
+	d.Set("replications", replications)
+	d.Set("subscriptions", subscriptions)
+	d.SetId(generateDataSourceReplicationStatusID(d))
+
+	return nil
+}
+
+func readReplicationStatus(db *DBConnection, filters []string) ([]any, error) {
+	query := finalizeQueryWithFilters(replicationStatusQuery, queryConcatKeywordWhere, filters)
+	query += " ORDER BY application_name, pid"
+
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("could not read pg_stat_replication: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("error closing rows: %v", err)
+		}
+	}()
+
+	replications := make([]any, 0)
+	for rows.Next() {
+		var pid int
+		var applicationName, clientAddr, state, syncState, slotName string
+		var sentLSN, writeLSN, flushLSN, replayLSN sql.NullString
+		var writeLag, flushLag, replayLag sql.NullFloat64
+		var replayLagBytes sql.NullInt64
+
+		if err = rows.Scan(
+			&pid, &applicationName, &clientAddr, &state, &syncState, &slotName,
+			&sentLSN, &writeLSN, &flushLSN, &replayLSN, &writeLag, &flushLag, &replayLag, &replayLagBytes,
+		); err != nil {
+			return nil, fmt.Errorf("could not scan pg_stat_replication output: %w", err)
+		}
+
+		result := make(map[string]any)
+		result["pid"] = pid
+		result["application_name"] = applicationName
+		result["client_addr"] = clientAddr
+		result["state"] = state
+		result["sync_state"] = syncState
+		result["slot_name"] = slotName
+		result["sent_lsn"] = sentLSN.String
+		result["write_lsn"] = writeLSN.String
+		result["flush_lsn"] = flushLSN.String
+		result["replay_lsn"] = replayLSN.String
+		result["write_lag"] = writeLag.Float64
+		result["flush_lag"] = flushLag.Float64
+		result["replay_lag"] = replayLag.Float64
+		result["replay_lag_bytes"] = int(replayLagBytes.Int64)
+		replications = append(replications, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read pg_stat_replication: %w", err)
+	}
+
+	return replications, nil
+}
+
+func readSubscriptionStatus(db *DBConnection, filters []string) ([]any, error) {
+	if !db.featureSupported(featurePublication) {
+		return []any{}, nil
+	}
+
+	workerFilter := ""
+	if db.featureSupported(featureSubscriptionStreamingParallel) {
+		workerFilter = "AND ss.leader_pid IS NULL"
+	}
+	query := finalizeQueryWithFilters(fmt.Sprintf(subscriptionStatusQuery, workerFilter), queryConcatKeywordWhere, filters)
+	query += " ORDER BY subscription_name"
+
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("could not read pg_stat_subscription: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("error closing rows: %v", err)
+		}
+	}()
+
+	subscriptions := make([]any, 0)
+	for rows.Next() {
+		var subscriptionName, slotName string
+		var pid sql.NullInt64
+		var receivedLSN, latestEndLSN, lastMsgSendTime, lastMsgReceiptTime, latestEndTime sql.NullString
+		var receiptLag sql.NullFloat64
+
+		if err = rows.Scan(
+			&subscriptionName, &pid, &slotName, &receivedLSN, &latestEndLSN,
+			&lastMsgSendTime, &lastMsgReceiptTime, &latestEndTime, &receiptLag,
+		); err != nil {
+			return nil, fmt.Errorf("could not scan pg_stat_subscription output: %w", err)
+		}
+
+		result := make(map[string]any)
+		result["subscription_name"] = subscriptionName
+		result["pid"] = int(pid.Int64)
+		result["slot_name"] = slotName
+		result["received_lsn"] = receivedLSN.String
+		result["latest_end_lsn"] = latestEndLSN.String
+		result["last_msg_send_time"] = lastMsgSendTime.String
+		result["last_msg_receipt_time"] = lastMsgReceiptTime.String
+		result["latest_end_time"] = latestEndTime.String
+		result["receipt_lag"] = receiptLag.Float64
+		subscriptions = append(subscriptions, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read pg_stat_subscription: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+func generateDataSourceReplicationStatusID(d *schema.ResourceData) string {
+	return strings.Join([]string{
+		"replication_status",
+		generatePatternArrayString(d.Get("like_any_patterns").([]any), queryArrayKeywordAny),
+		generatePatternArrayString(d.Get("like_all_patterns").([]any), queryArrayKeywordAll),
+		generatePatternArrayString(d.Get("not_like_all_patterns").([]any), queryArrayKeywordAll),
+		d.Get("regex_pattern").(string),
+	}, "_")
+}
@@ -0,0 +1,38 @@
+package postgresql
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPostgresqlDataSourceReplicationStatus(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testSuperuserPreCheck(t)
+			testCheckCompatibleVersion(t, featureWALFunctions)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// The test server has no standby nor subscription, this checks that the queries are valid
+				// and that the filters are applied.
+				Config: `
+				data "postgresql_replication_status" "all" {}
+
+				data "postgresql_replication_status" "filtered" {
+					like_any_patterns = ["tf_tests_unknown_slot%"]
+					regex_pattern     = "^tf_tests"
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.postgresql_replication_status.all", "replications.#"),
+					resource.TestCheckResourceAttrSet("data.postgresql_replication_status.all", "subscriptions.#"),
+					resource.TestCheckResourceAttr("data.postgresql_replication_status.filtered", "replications.#", "0"),
+					resource.TestCheckResourceAttr("data.postgresql_replication_status.filtered", "subscriptions.#", "0"),
+				),
+			},
+		},
+	})
+}
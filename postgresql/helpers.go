@@ -1,6 +1,7 @@
 package postgresql
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -37,6 +38,23 @@ func PGResourceExistsFunc(fn func(*DBConnection, *schema.ResourceData) (bool, er
 	}
 }
 
+func PGResourceImportFunc(fn func(*DBConnection, *schema.ResourceData) error) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		client := meta.(*Client)
+
+		db, err := client.Connect()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := fn(db, d); err != nil {
+			return nil, err
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
 // QueryAble is a DB connection (sql.DB/Tx)
 type QueryAble interface {
 	Exec(query string, args ...any) (sql.Result, error)
@@ -258,9 +276,14 @@ var allowedPrivileges = map[string][]string{
 	"procedure":            {"ALL", "EXECUTE"},
 	"routine":              {"ALL", "EXECUTE"},
 	"type":                 {"ALL", "USAGE"},
+	"domain":               {"ALL", "USAGE"},
+	"language":             {"ALL", "USAGE"},
+	"large_object":         {"ALL", "SELECT", "UPDATE"},
+	"parameter":            {"ALL", "SET", "ALTER SYSTEM"},
 	"foreign_data_wrapper": {"ALL", "USAGE"},
 	"foreign_server":       {"ALL", "USAGE"},
 	"column":               {"ALL", "SELECT", "INSERT", "UPDATE", "REFERENCES"},
+	"tablespace":           {"ALL", "CREATE"},
 }
 
 // validatePrivileges checks that privileges to apply are allowed for this object type.
@@ -443,6 +466,99 @@ func schemaExists(txn *sql.Tx, schemaname string) (bool, error) {
 	return true, nil
 }
 
+// importIDMatcher reports whether name, parsed from an import ID, is the name of an existing object.
+// matched contains the names already parsed from the ID.
+type importIDMatcher func(matched []string, name string) (bool, error)
+
+// splitImportID splits an import ID made of names joined with "_" (e.g.: the ID built by generateGrantID).
+// As the names can contain "_" themselves, the possible splits are tried until each name is accepted
+// by its matcher: fixed contains one matcher per leading name and repeated, if not nil, has to accept
+// each one of the remaining names.
+// It returns nil if the ID cannot be split.
+func splitImportID(id string, fixed []importIDMatcher, repeated importIDMatcher) ([]string, error) {
+	return matchImportIDParts(strings.Split(id, "_"), []string{}, fixed, repeated)
+}
+
+func matchImportIDParts(parts, matched []string, fixed []importIDMatcher, repeated importIDMatcher) ([]string, error) {
+	if len(parts) == 0 {
+		if len(fixed) > 0 {
+			return nil, nil
+		}
+		return matched, nil
+	}
+
+	matcher, next := repeated, fixed
+	if len(fixed) > 0 {
+		matcher, next = fixed[0], fixed[1:]
+	}
+	if matcher == nil {
+		return nil, nil
+	}
+
+	for i := 1; i <= len(parts); i++ {
+		name := strings.Join(parts[:i], "_")
+		ok, err := matcher(matched, name)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		names := append(append([]string{}, matched...), name)
+		result, err := matchImportIDParts(parts[i:], names, next, repeated)
+		if err != nil || result != nil {
+			return result, err
+		}
+	}
+
+	return nil, nil
+}
+
+// objectExistsInDB returns true if the query, executed in the specified database, returns a row.
+func objectExistsInDB(db *DBConnection, database, query string, args ...any) (bool, error) {
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return false, err
+	}
+	defer deferredRollback(txn)
+
+	var found int
+	err = txn.QueryRow(query, args...).Scan(&found)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("could not check if object exists: %w", err)
+	}
+
+	return true, nil
+}
+
+// importRoleMatcher matches the existing roles, including public if allowPublic is true.
+func importRoleMatcher(db *DBConnection, allowPublic bool) importIDMatcher {
+	return func(_ []string, name string) (bool, error) {
+		if name == publicRole {
+			return allowPublic, nil
+		}
+		return objectExistsInDB(db, "", "SELECT 1 FROM pg_catalog.pg_roles WHERE rolname = $1", name)
+	}
+}
+
+// importDatabaseMatcher matches the existing databases.
+func importDatabaseMatcher(db *DBConnection) importIDMatcher {
+	return func(_ []string, name string) (bool, error) {
+		return dbExists(db, name)
+	}
+}
+
+// importSchemaMatcher matches the existing schemas of the database parsed at databaseIndex.
+func importSchemaMatcher(db *DBConnection, databaseIndex int) importIDMatcher {
+	return func(matched []string, name string) (bool, error) {
+		return objectExistsInDB(db, matched[databaseIndex], "SELECT 1 FROM pg_catalog.pg_namespace WHERE nspname = $1", name)
+	}
+}
+
 func getCurrentUser(db QueryAble) (string, error) {
 	var currentUser string
 	err := db.QueryRow("SELECT CURRENT_USER").Scan(&currentUser)
@@ -664,6 +780,81 @@ func defaultDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	return old == new
 }
 
+// quoteParameterName quotes the name of a configuration parameter,
+// custom parameters are prefixed by the extension name (e.g.: pgaudit.log).
+func quoteParameterName(name string) string {
+	parts := strings.Split(name, ".")
+	for i := range parts {
+		parts[i] = pq.QuoteIdentifier(parts[i])
+	}
+	return strings.Join(parts, ".")
+}
+
+// listParameters are the configuration parameters which accept a list of values.
+var listParameters = []string{
+	"search_path",
+	"temp_tablespaces",
+	"local_preload_libraries",
+	"session_preload_libraries",
+}
+
+// formatParameterValue quotes the value of a configuration parameter.
+// The values of list parameters are split so each element is quoted separately,
+// e.g.: search_path = "$user", public -> '$user', 'public'
+func formatParameterValue(name, value string) string {
+	if !sliceContainsStr(listParameters, strings.ToLower(name)) {
+		return pq.QuoteLiteral(value)
+	}
+
+	values := strings.Split(value, ",")
+	for i := range values {
+		values[i] = pq.QuoteLiteral(strings.Trim(strings.TrimSpace(values[i]), `"`))
+	}
+	return strings.Join(values, ", ")
+}
+
+// readParameters parses the configuration parameters stored as name=value
+// in the rolconfig column of pg_roles or the setconfig column of pg_db_role_setting.
+func readParameters(config pq.ByteaArray) map[string]string {
+	parameters := make(map[string]string, len(config))
+	for _, v := range config {
+		name, value, found := strings.Cut(string(v), "=")
+		if !found {
+			continue
+		}
+		parameters[name] = value
+	}
+	return parameters
+}
+
+// alterParameters resets the parameters removed from newParameters and sets the new or modified ones
+// on the target of the ALTER query (e.g.: ROLE "foo", DATABASE "bar" or ROLE "foo" IN DATABASE "bar").
+func alterParameters(db QueryAble, target string, oldParameters, newParameters map[string]any) error {
+	for name := range oldParameters {
+		if _, ok := newParameters[name]; ok {
+			continue
+		}
+		sql := fmt.Sprintf("ALTER %s RESET %s", target, quoteParameterName(name))
+		if _, err := db.Exec(sql); err != nil {
+			return fmt.Errorf("could not reset %s for %s: %w", name, target, err)
+		}
+	}
+
+	for name, value := range newParameters {
+		if oldValue, ok := oldParameters[name]; ok && oldValue == value {
+			continue
+		}
+		sql := fmt.Sprintf(
+			"ALTER %s SET %s TO %s", target, quoteParameterName(name), formatParameterValue(name, value.(string)),
+		)
+		if _, err := db.Exec(sql); err != nil {
+			return fmt.Errorf("could not set %s %s for %s: %w", name, value, target, err)
+		}
+	}
+
+	return nil
+}
+
 // quoteTable can quote a table name with or without a schema prefix
 // Example:
 //
@@ -676,3 +867,70 @@ func quoteTableName(tableName string) string {
 	}
 	return strings.Join(parts, ".")
 }
+
+// resolveCatalogName returns the configured name of a type or a function if it refers to the
+// same object as the name read from the catalog (e.g.: int4 and integer), so aliases don't produce a diff.
+// regType is the object identifier type used to look up the configured name (e.g.: regtype, regproc).
+func resolveCatalogName(txn *sql.Tx, regType, configured, catalogName string) (string, error) {
+	if configured == "" || catalogName == "" || configured == catalogName {
+		return catalogName, nil
+	}
+
+	var resolved sql.NullString
+	query := fmt.Sprintf("SELECT to_%s($1)::%s::text", regType, regType)
+	if err := txn.QueryRow(query, configured).Scan(&resolved); err != nil {
+		return "", fmt.Errorf("could not resolve %s %s: %w", regType, configured, err)
+	}
+
+	if resolved.Valid && resolved.String == catalogName {
+		return configured, nil
+	}
+	return catalogName, nil
+}
+
+// normalizeExpression removes the whitespaces and the parentheses wrapping the whole expression
+// as Postgres returns some expressions as `(expression)`.
+func normalizeExpression(expression string) string {
+	expression = strings.Join(strings.Fields(expression), " ")
+
+	for len(expression) >= 2 && expression[0] == '(' && expression[len(expression)-1] == ')' {
+		// Check that the first parenthesis is closed by the last one, e.g.: not `(a) OR (b)`
+		depth := 0
+		wrapped := true
+		for i, c := range expression {
+			switch c {
+			case '(':
+				depth++
+			case ')':
+				depth--
+			}
+			if depth == 0 && i < len(expression)-1 {
+				wrapped = false
+				break
+			}
+		}
+		if !wrapped {
+			break
+		}
+		expression = strings.TrimSpace(expression[1 : len(expression)-1])
+	}
+
+	return expression
+}
+
+// resolveStateExpression returns the expression of the state if Postgres has not changed it since it was applied.
+// Postgres stores expressions rewritten (e.g.: `status = 'active'` is stored as `(status = 'active'::text)`),
+// so the expression read from the catalog is kept in a definition and the state expression is only replaced
+// if the catalog expression differs from this definition. An empty definition means the expression has just been applied.
+func resolveStateExpression(stateExpression, definition, catalogExpression string) string {
+	if stateExpression == "" || catalogExpression == "" {
+		return catalogExpression
+	}
+	if normalizeExpression(stateExpression) == normalizeExpression(catalogExpression) {
+		return stateExpression
+	}
+	if definition == "" || definition == catalogExpression {
+		return stateExpression
+	}
+	return catalogExpression
+}
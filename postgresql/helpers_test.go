@@ -47,6 +47,40 @@ func TestQuoteTableName(t *testing.T) {
 	}
 }
 
+func TestFormatParameterValue(t *testing.T) {
+	tests := []struct {
+		name      string
+		parameter string
+		value     string
+		expected  string
+	}{
+		{
+			name:      "simple parameter",
+			parameter: "work_mem",
+			value:     "64MB",
+			expected:  `'64MB'`,
+		},
+		{
+			name:      "custom parameter with a list of values",
+			parameter: "pgaudit.log",
+			value:     "read, write",
+			expected:  `'read, write'`,
+		},
+		{
+			name:      "list parameter",
+			parameter: "search_path",
+			value:     `"$user", public`,
+			expected:  `'$user', 'public'`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, formatParameterValue(tt.parameter, tt.value))
+		})
+	}
+}
+
 func TestArePrivilegesEqual(t *testing.T) {
 
 	type PrivilegesTestObject struct {
@@ -121,3 +155,94 @@ func buildResourceData(objectType string, t *testing.T) *schema.ResourceData {
 	m["object_type"] = objectType
 	return schema.TestResourceDataRaw(t, testSchema, m)
 }
+
+func TestSplitImportID(t *testing.T) {
+	// Fake catalog used by the matchers
+	roles := []string{"app", "app_ro", "public"}
+	databases := []string{"my_db", "my"}
+	objects := []string{"orders", "order_items"}
+
+	inList := func(list []string) importIDMatcher {
+		return func(_ []string, name string) (bool, error) {
+			return sliceContainsStr(list, name), nil
+		}
+	}
+
+	tests := []struct {
+		name     string
+		id       string
+		fixed    []importIDMatcher
+		repeated importIDMatcher
+		expected []string
+	}{
+		{
+			name:     "simple names",
+			id:       "app_my_db",
+			fixed:    []importIDMatcher{inList(roles), inList(databases)},
+			expected: []string{"app", "my_db"},
+		},
+		{
+			name:     "names containing separator",
+			id:       "app_ro_my_db_table",
+			fixed:    []importIDMatcher{inList(roles), inList(databases), inList([]string{"table"})},
+			expected: []string{"app_ro", "my_db", "table"},
+		},
+		{
+			name:     "repeated names",
+			id:       "app_my_db_table_orders_order_items",
+			fixed:    []importIDMatcher{inList(roles), inList(databases), inList([]string{"table"})},
+			repeated: inList(objects),
+			expected: []string{"app", "my_db", "table", "orders", "order_items"},
+		},
+		{
+			name:     "unexpected remaining names",
+			id:       "app_my_db_orders",
+			fixed:    []importIDMatcher{inList(roles), inList(databases)},
+			expected: nil,
+		},
+		{
+			name:     "missing names",
+			id:       "app",
+			fixed:    []importIDMatcher{inList(roles), inList(databases)},
+			expected: nil,
+		},
+		{
+			name:     "unknown name",
+			id:       "app_other_db",
+			fixed:    []importIDMatcher{inList(roles), inList(databases)},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := splitImportID(tt.id, tt.fixed, tt.repeated)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, parsed)
+		})
+	}
+}
+
+func TestNormalizeExpression(t *testing.T) {
+	assert.Equal(t, "", normalizeExpression(""))
+	assert.Equal(t, "id > 10", normalizeExpression("id > 10"))
+	assert.Equal(t, "id > 10", normalizeExpression("(id > 10)"))
+	assert.Equal(t, "id > 10", normalizeExpression(" (( id  >\n10 )) "))
+	assert.Equal(t, "(a > 1) OR (b < 2)", normalizeExpression("(a > 1) OR (b < 2)"))
+	assert.Equal(t, "(a > 1) AND (b IN (1, 2))", normalizeExpression("((a > 1) AND (b IN (1, 2)))"))
+}
+
+func TestResolveStateExpression(t *testing.T) {
+	// Import: the catalog expression is used.
+	assert.Equal(t, "(val = 'a'::text)", resolveStateExpression("", "", "(val = 'a'::text)"))
+	// The expression has been removed from the database.
+	assert.Equal(t, "", resolveStateExpression("val = 'a'", "(val = 'a'::text)", ""))
+	// Same expression once normalized.
+	assert.Equal(t, "id > 10", resolveStateExpression("id > 10", "(id > 1)", "(id > 10)"))
+	// The expression has just been applied.
+	assert.Equal(t, "val = 'a'", resolveStateExpression("val = 'a'", "", "(val = 'a'::text)"))
+	// The expression has not changed since it was applied.
+	assert.Equal(t, "val = 'a'", resolveStateExpression("val = 'a'", "(val = 'a'::text)", "(val = 'a'::text)"))
+	// The expression has been changed outside of Terraform.
+	assert.Equal(t, "(val = 'b'::text)", resolveStateExpression("val = 'a'", "(val = 'a'::text)", "(val = 'b'::text)"))
+}
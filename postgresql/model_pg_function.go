@@ -18,6 +18,12 @@ type PGFunction struct {
 	SecurityDefiner bool
 	Strict          bool
 	Volatility      string
+	Leakproof       bool
+	Cost            float64
+	Rows            float64
+	Support         string
+	Parameters      map[string]string
+	ReturnsTable    []PGFunctionArg
 }
 
 type PGFunctionArg struct {
@@ -65,6 +71,24 @@ func (pgFunction *PGFunction) FromResourceData(d *schema.ResourceData) error {
 	} else {
 		pgFunction.Volatility = defaultFunctionVolatility
 	}
+	if v, ok := d.GetOk(funcLeakproofAttr); ok {
+		pgFunction.Leakproof = v.(bool)
+	}
+	if v, ok := d.GetOk(funcCostAttr); ok {
+		pgFunction.Cost = v.(float64)
+	}
+	if v, ok := d.GetOk(funcRowsAttr); ok {
+		pgFunction.Rows = v.(float64)
+	}
+	if v, ok := d.GetOk(funcSupportAttr); ok {
+		pgFunction.Support = v.(string)
+	}
+	if v, ok := d.GetOk(funcSetAttr); ok {
+		pgFunction.Parameters = map[string]string{}
+		for name, value := range v.(map[string]any) {
+			pgFunction.Parameters[name] = value.(string)
+		}
+	}
 
 	// For the main returns if not provided
 	argOutput := "void"
@@ -100,7 +124,19 @@ func (pgFunction *PGFunction) FromResourceData(d *schema.ResourceData) error {
 		}
 	}
 
-	if v, ok := d.GetOk(funcReturnsAttr); ok {
+	if columns, ok := d.GetOk(funcReturnsTableAttr); ok {
+		var columnDefinitions []string
+		for _, column := range columns.([]any) {
+			column := column.(map[string]any)
+			pgColumn := PGFunctionArg{
+				Name: column[funcArgNameAttr].(string),
+				Type: column[funcArgTypeAttr].(string),
+			}
+			pgFunction.ReturnsTable = append(pgFunction.ReturnsTable, pgColumn)
+			columnDefinitions = append(columnDefinitions, pgColumn.Name+" "+pgColumn.Type)
+		}
+		pgFunction.Returns = "TABLE(" + strings.Join(columnDefinitions, ", ") + ")"
+	} else if v, ok := d.GetOk(funcReturnsAttr); ok {
 		pgFunction.Returns = v.(string)
 	} else {
 		pgFunction.Returns = argOutput
@@ -150,10 +186,30 @@ func (pgFunction *PGFunction) Parse(functionDefinition string) error {
 	} else {
 		pgFunction.Parallel = strings.TrimPrefix(pgFunctionData["Parallel"], "PARALLEL ")
 	}
+	pgFunction.ReturnsTable = parseFunctionReturnsTable(pgFunction.Returns)
 
 	return nil
 }
 
+// parseFunctionReturnsTable parses the columns of a RETURNS TABLE(...) return type.
+func parseFunctionReturnsTable(returns string) []PGFunctionArg {
+	tableData := findStringSubmatchMap(`(?si)^TABLE\s*\((?P<Columns>.*)\)$`, strings.TrimSpace(returns))
+	columnsData, ok := tableData["Columns"]
+	if !ok {
+		return nil
+	}
+
+	var columns []PGFunctionArg
+	for _, rawColumn := range strings.Split(columnsData, ",") {
+		columnData := findStringSubmatchMap(`(?si)^(?P<Name>[^\s]+)\s(?P<Type>.*)$`, strings.TrimSpace(rawColumn))
+		columns = append(columns, PGFunctionArg{
+			Name: columnData["Name"],
+			Type: columnData["Type"],
+		})
+	}
+	return columns
+}
+
 func (pgFunctionArg *PGFunctionArg) Parse(functionArgDefinition string) error {
 
 	// Check if default exists
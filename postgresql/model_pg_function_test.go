@@ -200,6 +200,84 @@ MultiLine Function
 	})
 }
 
+func TestPGFunctionParseWithOptions(t *testing.T) {
+
+	var functionDefinition = `CREATE OR REPLACE FUNCTION public.pg_func_test(num integer)
+ RETURNS TABLE(id integer, label text)
+ LANGUAGE plpgsql
+ STABLE STRICT SECURITY DEFINER LEAKPROOF
+ COST 42
+ ROWS 10
+ SUPPORT public.pg_func_support
+ SET search_path TO 'public', 'pg_temp'
+ SET work_mem TO '64MB'
+AS $function$
+BEGIN RETURN QUERY SELECT num, 'SET x TO y'; END;
+$function$
+`
+
+	var pgFunction PGFunction
+
+	err := pgFunction.Parse(functionDefinition)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, PGFunction{
+		Name:            "pg_func_test",
+		Schema:          "public",
+		Returns:         "TABLE(id integer, label text)",
+		Language:        "plpgsql",
+		Parallel:        defaultFunctionParallel,
+		SecurityDefiner: true,
+		Strict:          true,
+		Volatility:      "STABLE",
+		ReturnsTable: []PGFunctionArg{
+			{Name: "id", Type: "integer"},
+			{Name: "label", Type: "text"},
+		},
+		Body: `
+BEGIN RETURN QUERY SELECT num, 'SET x TO y'; END;
+`,
+		Args: []PGFunctionArg{
+			{
+				Mode: "IN",
+				Name: "num",
+				Type: "integer",
+			},
+		},
+	}, pgFunction)
+}
+
+func TestFromResourceDataWithReturnsTable(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourcePostgreSQLFunction().Schema, map[string]any{
+		"name": "list_items",
+		"body": "SELECT 1, 'one'",
+		"returns_table": []any{
+			map[string]any{"name": "id", "type": "integer"},
+			map[string]any{"name": "label", "type": "text"},
+		},
+		"leakproof": true,
+		"cost":      5.5,
+		"rows":      10,
+		"set":       map[string]any{"search_path": "public, pg_temp"},
+	})
+
+	var pgFunction PGFunction
+
+	err := pgFunction.FromResourceData(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "TABLE(id integer, label text)", pgFunction.Returns)
+	assert.Equal(t, []PGFunctionArg{{Name: "id", Type: "integer"}, {Name: "label", Type: "text"}}, pgFunction.ReturnsTable)
+	assert.True(t, pgFunction.Leakproof)
+	assert.Equal(t, 5.5, pgFunction.Cost)
+	assert.Equal(t, float64(10), pgFunction.Rows)
+	assert.Equal(t, map[string]string{"search_path": "public, pg_temp"}, pgFunction.Parameters)
+}
+
 func TestPGFunctionArgParseWithDefault(t *testing.T) {
 
 	var functionArgDefinition = `default_null integer DEFAULT NULL::integer`
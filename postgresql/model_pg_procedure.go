@@ -0,0 +1,103 @@
+package postgresql
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// PGProcedure is the model for the database procedure
+type PGProcedure struct {
+	Schema          string
+	Name            string
+	Language        string
+	Body            string
+	Args            []PGFunctionArg
+	SecurityDefiner bool
+	Parameters      map[string]string
+}
+
+func (pgProcedure *PGProcedure) FromResourceData(d *schema.ResourceData) error {
+
+	if v, ok := d.GetOk(procSchemaAttr); ok {
+		pgProcedure.Schema = v.(string)
+	} else {
+		pgProcedure.Schema = "public"
+	}
+
+	pgProcedure.Name = d.Get(procNameAttr).(string)
+	if v, ok := d.GetOk(procLanguageAttr); ok {
+		pgProcedure.Language = v.(string)
+	} else {
+		pgProcedure.Language = "plpgsql"
+	}
+	pgProcedure.Body = normalizeFunctionBody(d.Get(procBodyAttr).(string))
+	pgProcedure.SecurityDefiner = d.Get(procSecurityDefinerAttr).(bool)
+	pgProcedure.Args = []PGFunctionArg{}
+
+	if args, ok := d.GetOk(procArgAttr); ok {
+		for _, arg := range args.([]any) {
+			arg := arg.(map[string]any)
+
+			var pgArg PGFunctionArg
+
+			if v, ok := arg[funcArgModeAttr]; ok {
+				pgArg.Mode = v.(string)
+			}
+
+			if v, ok := arg[funcArgNameAttr]; ok {
+				pgArg.Name = v.(string)
+			}
+
+			pgArg.Type = arg[funcArgTypeAttr].(string)
+
+			if v, ok := arg[funcArgDefaultAttr]; ok {
+				pgArg.Default = v.(string)
+			}
+
+			pgProcedure.Args = append(pgProcedure.Args, pgArg)
+		}
+	}
+
+	pgProcedure.Parameters = map[string]string{}
+	for name, value := range d.Get(procSetAttr).(map[string]any) {
+		pgProcedure.Parameters[name] = value.(string)
+	}
+
+	return nil
+}
+
+// Parse reads the procedure definition returned by pg_get_functiondef.
+// The SET clauses are not parsed as they are read from pg_proc.proconfig.
+func (pgProcedure *PGProcedure) Parse(procedureDefinition string) error {
+
+	pgProcedureData := findStringSubmatchMap(
+		`(?si)CREATE\sOR\sREPLACE\sPROCEDURE\s(?P<Schema>[^.]+)\.(?P<Name>[^(]+)\((?P<Args>.*)\)\s*LANGUAGE\s(?P<Language>[^\n\s]+)\s*(?P<Security>(SECURITY DEFINER)?).*?\$[a-zA-Z]*\$(?P<Body>.*)\$[a-zA-Z]*\$`,
+		procedureDefinition,
+	)
+
+	argsData := pgProcedureData["Args"]
+
+	args := []PGFunctionArg{}
+
+	if argsData != "" {
+		rawArgs := strings.Split(argsData, ",")
+		for i := 0; i < len(rawArgs); i++ {
+			var arg PGFunctionArg
+			err := arg.Parse(strings.TrimSpace(rawArgs[i]))
+			if err != nil {
+				continue
+			}
+			args = append(args, arg)
+		}
+	}
+
+	pgProcedure.Schema = pgProcedureData["Schema"]
+	pgProcedure.Name = pgProcedureData["Name"]
+	pgProcedure.Language = pgProcedureData["Language"]
+	pgProcedure.Body = pgProcedureData["Body"]
+	pgProcedure.Args = args
+	pgProcedure.SecurityDefiner = len(pgProcedureData["Security"]) > 0
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package postgresql
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPGProcedureFromResourceData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourcePostgreSQLProcedure().Schema, map[string]any{
+		"name":             "transfer",
+		"body":             "$$ BEGIN NULL; END; $$",
+		"security_definer": true,
+		"set":              map[string]any{"search_path": "bank, pg_temp"},
+		"arg": []any{
+			map[string]any{"name": "amount", "type": "numeric"},
+			map[string]any{"name": "result", "type": "integer", "mode": "INOUT"},
+		},
+	})
+
+	var pgProcedure PGProcedure
+
+	err := pgProcedure.FromResourceData(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, PGProcedure{
+		Schema:          "public",
+		Name:            "transfer",
+		Language:        "plpgsql",
+		Body:            "BEGIN NULL; END;",
+		SecurityDefiner: true,
+		Parameters:      map[string]string{"search_path": "bank, pg_temp"},
+		Args: []PGFunctionArg{
+			{Name: "amount", Type: "numeric"},
+			{Name: "result", Type: "integer", Mode: "INOUT"},
+		},
+	}, pgProcedure)
+
+	assert.Equal(t, `CREATE OR REPLACE PROCEDURE "public"."transfer" (
+    amount numeric,
+    INOUT result integer
+)
+LANGUAGE plpgsql
+SECURITY DEFINER
+SET "search_path" TO 'bank', 'pg_temp'
+AS $procedure$BEGIN NULL; END;$procedure$;`, createProcedureQuery(pgProcedure, true))
+}
+
+func TestPGProcedureParse(t *testing.T) {
+
+	var procedureDefinition = `CREATE OR REPLACE PROCEDURE public.transfer(IN amount numeric, INOUT result integer DEFAULT 0, OUT total numeric)
+ LANGUAGE plpgsql
+ SECURITY DEFINER
+ SET search_path TO 'bank', 'pg_temp'
+AS $procedure$
+BEGIN NULL; END;
+$procedure$
+`
+
+	var pgProcedure PGProcedure
+
+	err := pgProcedure.Parse(procedureDefinition)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, PGProcedure{
+		Schema:          "public",
+		Name:            "transfer",
+		Language:        "plpgsql",
+		SecurityDefiner: true,
+		Body: `
+BEGIN NULL; END;
+`,
+		Args: []PGFunctionArg{
+			{Mode: "IN", Name: "amount", Type: "numeric"},
+			{Mode: "INOUT", Name: "result", Type: "integer", Default: "0"},
+			{Mode: "OUT", Name: "total", Type: "numeric"},
+		},
+	}, pgProcedure)
+}
@@ -207,6 +207,8 @@ func Provider() *schema.Provider {
 			"postgresql_extension":                 resourcePostgreSQLExtension(),
 			"postgresql_grant":                     resourcePostgreSQLGrant(),
 			"postgresql_grant_role":                resourcePostgreSQLGrantRole(),
+			"postgresql_grants":                    resourcePostgreSQLGrants(),
+			"postgresql_grants_exclusive":          resourcePostgreSQLGrantsExclusive(),
 			"postgresql_replication_slot":          resourcePostgreSQLReplicationSlot(),
 			"postgresql_publication":               resourcePostgreSQLPublication(),
 			"postgresql_subscription":              resourcePostgreSQLSubscription(),
@@ -214,16 +216,30 @@ func Provider() *schema.Provider {
 			"postgresql_schema":                    resourcePostgreSQLSchema(),
 			"postgresql_role":                      resourcePostgreSQLRole(),
 			"postgresql_function":                  resourcePostgreSQLFunction(),
+			"postgresql_procedure":                 resourcePostgreSQLProcedure(),
+			"postgresql_aggregate":                 resourcePostgreSQLAggregate(),
+			"postgresql_operator":                  resourcePostgreSQLOperator(),
+			"postgresql_cast":                      resourcePostgreSQLCast(),
 			"postgresql_server":                    resourcePostgreSQLServer(),
 			"postgresql_user_mapping":              resourcePostgreSQLUserMapping(),
 			"postgresql_security_label":            resourcePostgreSQLSecurityLabel(),
+			"postgresql_table":                     resourcePostgreSQLTable(),
+			"postgresql_view":                      resourcePostgreSQLView(),
+			"postgresql_materialized_view":         resourcePostgreSQLMaterializedView(),
+			"postgresql_policy":                    resourcePostgreSQLPolicy(),
+			"postgresql_row_level_security":        resourcePostgreSQLRowLevelSecurity(),
+			"postgresql_role_database_settings":    resourcePostgreSQLRoleDatabaseSettings(),
+			"postgresql_tablespace":                resourcePostgreSQLTablespace(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"postgresql_schemas":   dataSourcePostgreSQLDatabaseSchemas(),
-			"postgresql_tables":    dataSourcePostgreSQLDatabaseTables(),
-			"postgresql_sequences": dataSourcePostgreSQLDatabaseSequences(),
-			"postgresql_query":     dataSourcePostgreSQLQuery(),
+			"postgresql_schemas":            dataSourcePostgreSQLDatabaseSchemas(),
+			"postgresql_tables":             dataSourcePostgreSQLDatabaseTables(),
+			"postgresql_sequences":          dataSourcePostgreSQLDatabaseSequences(),
+			"postgresql_query":              dataSourcePostgreSQLQuery(),
+			"postgresql_import_blocks":      dataSourcePostgreSQLImportBlocks(),
+			"postgresql_replication_slots":  dataSourcePostgreSQLReplicationSlots(),
+			"postgresql_replication_status": dataSourcePostgreSQLReplicationStatus(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -0,0 +1,393 @@
+package postgresql
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/lib/pq"
+)
+
+const (
+	aggNameAttr        = "name"
+	aggSchemaAttr      = "schema"
+	aggDatabaseAttr    = "database"
+	aggArgAttr         = "arg"
+	aggSFuncAttr       = "sfunc"
+	aggSTypeAttr       = "stype"
+	aggFinalFuncAttr   = "finalfunc"
+	aggCombineFuncAttr = "combinefunc"
+	aggInitCondAttr    = "initcond"
+	aggParallelAttr    = "parallel"
+	aggDropCascadeAttr = "drop_cascade"
+)
+
+var aggregateParallelModes = map[string]string{
+	"s": "SAFE",
+	"r": "RESTRICTED",
+	"u": "UNSAFE",
+}
+
+func resourcePostgreSQLAggregate() *schema.Resource {
+	return &schema.Resource{
+		Create: PGResourceFunc(resourcePostgreSQLAggregateCreate),
+		Read:   PGResourceFunc(resourcePostgreSQLAggregateRead),
+		Update: PGResourceFunc(resourcePostgreSQLAggregateUpdate),
+		Delete: PGResourceFunc(resourcePostgreSQLAggregateDelete),
+		Exists: PGResourceExistsFunc(resourcePostgreSQLAggregateExists),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			aggNameAttr: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the aggregate.",
+			},
+			aggSchemaAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Schema where the aggregate is located. Defaults to public.",
+			},
+			aggDatabaseAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The database where the aggregate is located. If not specified, the provider default database is used.",
+			},
+			aggArgAttr: {
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						funcArgTypeAttr: {
+							Type:        schema.TypeString,
+							Description: "The argument type.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						funcArgNameAttr: {
+							Type:        schema.TypeString,
+							Description: "The argument name.",
+							Optional:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Aggregate argument definitions. An aggregate without arguments is created with *.",
+			},
+			aggSFuncAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The state transition function called for each input row.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			aggSTypeAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The data type of the aggregate's state value.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			aggFinalFuncAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The final function called to compute the aggregate's result after all input rows have been traversed.",
+			},
+			aggCombineFuncAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The function called to combine two aggregate states, needed for partial aggregation.",
+			},
+			aggInitCondAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The initial setting for the state value.",
+			},
+			aggParallelAttr: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "UNSAFE",
+				Description:  "Whether the aggregate is safe to run in parallel mode. One of: SAFE, RESTRICTED, UNSAFE",
+				ValidateFunc: validation.StringInSlice([]string{"SAFE", "RESTRICTED", "UNSAFE"}, false),
+			},
+			aggDropCascadeAttr: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Automatically drop objects that depend on the aggregate, and in turn all objects that depend on those objects.",
+			},
+		},
+	}
+}
+
+func resourcePostgreSQLAggregateCreate(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureAggregateParallel) {
+		if _, ok := d.GetOk(aggCombineFuncAttr); ok {
+			return fmt.Errorf("combinefunc is not supported for this Postgres version (%s)", db.version)
+		}
+		if d.Get(aggParallelAttr).(string) != "UNSAFE" {
+			return fmt.Errorf("parallel is not supported for this Postgres version (%s)", db.version)
+		}
+	}
+
+	database := getDatabase(d, db.client.databaseName)
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if _, err := txn.Exec(createAggregateQuery(d)); err != nil {
+		return fmt.Errorf("error creating aggregate %s: %w", d.Get(aggNameAttr).(string), err)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing aggregate: %w", err)
+	}
+
+	d.SetId(generateAggregateID(d, database))
+
+	return resourcePostgreSQLAggregateReadImpl(db, d)
+}
+
+func resourcePostgreSQLAggregateExists(db *DBConnection, d *schema.ResourceData) (bool, error) {
+	database, signature, err := expandFunctionID(d.Id(), d, db)
+	if err != nil {
+		return false, err
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return false, err
+	}
+	defer deferredRollback(txn)
+
+	var aggregateExists bool
+	if err := txn.QueryRow(
+		"SELECT EXISTS (SELECT 1 FROM pg_catalog.pg_aggregate WHERE aggfnoid::oid = to_regprocedure($1))",
+		signature,
+	).Scan(&aggregateExists); err != nil {
+		return false, fmt.Errorf("could not check if aggregate exists: %w", err)
+	}
+
+	return aggregateExists, nil
+}
+
+func resourcePostgreSQLAggregateRead(db *DBConnection, d *schema.ResourceData) error {
+	return resourcePostgreSQLAggregateReadImpl(db, d)
+}
+
+func resourcePostgreSQLAggregateReadImpl(db *DBConnection, d *schema.ResourceData) error {
+	database, signature, err := expandFunctionID(d.Id(), d, db)
+	if err != nil {
+		return err
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	combineFuncColumn := "''"
+	parallelColumn := "'u'"
+	if db.featureSupported(featureAggregateParallel) {
+		combineFuncColumn = "CASE WHEN a.aggcombinefn::oid = 0 THEN '' ELSE a.aggcombinefn::regproc::text END"
+		parallelColumn = "p.proparallel"
+	}
+
+	query := `SELECT n.nspname, p.proname, ` +
+		`ARRAY(SELECT t.typ::regtype::text FROM unnest(p.proargtypes::oid[]) WITH ORDINALITY AS t(typ, i) ORDER BY t.i), ` +
+		`COALESCE(p.proargnames, '{}'), ` +
+		`a.aggtransfn::regproc::text, a.aggtranstype::regtype::text, ` +
+		`CASE WHEN a.aggfinalfn::oid = 0 THEN '' ELSE a.aggfinalfn::regproc::text END, ` +
+		combineFuncColumn + `, COALESCE(a.agginitval, ''), ` + parallelColumn + ` ` +
+		`FROM pg_catalog.pg_aggregate a ` +
+		`JOIN pg_catalog.pg_proc p ON p.oid = a.aggfnoid ` +
+		`JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace ` +
+		`WHERE a.aggfnoid::oid = to_regprocedure($1) AND a.aggkind = 'n'`
+
+	var schemaName, name, sfunc, stype, finalFunc, combineFunc, initCond, parallel string
+	var argTypes, argNames []string
+
+	err = txn.QueryRow(query, signature).Scan(
+		&schemaName, &name, pq.Array(&argTypes), pq.Array(&argNames),
+		&sfunc, &stype, &finalFunc, &combineFunc, &initCond, &parallel,
+	)
+	switch {
+	case err == sql.ErrNoRows:
+		log.Printf("[WARN] PostgreSQL aggregate (%s) not found in database %s", signature, database)
+		d.SetId("")
+		return nil
+	case err != nil:
+		return fmt.Errorf("error reading aggregate: %w", err)
+	}
+
+	configuredArgs := d.Get(aggArgAttr).([]any)
+	args := make([]map[string]any, 0, len(argTypes))
+	for i, argType := range argTypes {
+		argName := ""
+		if i < len(argNames) {
+			argName = argNames[i]
+		}
+		if i < len(configuredArgs) {
+			configuredType := configuredArgs[i].(map[string]any)[funcArgTypeAttr].(string)
+			if argType, err = resolveCatalogName(txn, "regtype", configuredType, argType); err != nil {
+				return err
+			}
+		}
+		args = append(args, map[string]any{
+			funcArgTypeAttr: argType,
+			funcArgNameAttr: argName,
+		})
+	}
+
+	if stype, err = resolveCatalogName(txn, "regtype", d.Get(aggSTypeAttr).(string), stype); err != nil {
+		return err
+	}
+	for attr, value := range map[string]*string{
+		aggSFuncAttr:       &sfunc,
+		aggFinalFuncAttr:   &finalFunc,
+		aggCombineFuncAttr: &combineFunc,
+	} {
+		if *value, err = resolveCatalogName(txn, "regproc", d.Get(attr).(string), *value); err != nil {
+			return err
+		}
+	}
+
+	d.Set(aggDatabaseAttr, database)
+	d.Set(aggSchemaAttr, schemaName)
+	d.Set(aggNameAttr, name)
+	d.Set(aggArgAttr, args)
+	d.Set(aggSFuncAttr, sfunc)
+	d.Set(aggSTypeAttr, stype)
+	d.Set(aggFinalFuncAttr, finalFunc)
+	d.Set(aggCombineFuncAttr, combineFunc)
+	d.Set(aggInitCondAttr, initCond)
+	d.Set(aggParallelAttr, aggregateParallelModes[parallel])
+
+	return nil
+}
+
+// resourcePostgreSQLAggregateUpdate only handles drop_cascade, all the other attributes force a new aggregate.
+func resourcePostgreSQLAggregateUpdate(db *DBConnection, d *schema.ResourceData) error {
+	return resourcePostgreSQLAggregateReadImpl(db, d)
+}
+
+func resourcePostgreSQLAggregateDelete(db *DBConnection, d *schema.ResourceData) error {
+	database, signature, err := expandFunctionID(d.Id(), d, db)
+	if err != nil {
+		return err
+	}
+
+	dropMode := "RESTRICT"
+	if d.Get(aggDropCascadeAttr).(bool) {
+		dropMode = "CASCADE"
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if _, err := txn.Exec(dropAggregateQuery(signature, dropMode)); err != nil {
+		return fmt.Errorf("error deleting aggregate: %w", err)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing aggregate: %w", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// dropAggregateQuery returns the DROP AGGREGATE statement of an aggregate signature,
+// an aggregate without arguments has to be dropped as name(*).
+func dropAggregateQuery(signature, dropMode string) string {
+	if strings.HasSuffix(signature, "()") {
+		signature = strings.TrimSuffix(signature, "()") + "(*)"
+	}
+	return fmt.Sprintf("DROP AGGREGATE IF EXISTS %s %s", signature, dropMode)
+}
+
+func createAggregateQuery(d *schema.ResourceData) string {
+	b := bytes.NewBufferString("CREATE AGGREGATE ")
+	fmt.Fprint(b, pq.QuoteIdentifier(getAggregateSchema(d)), ".", pq.QuoteIdentifier(d.Get(aggNameAttr).(string)), " (")
+
+	args := d.Get(aggArgAttr).([]any)
+	if len(args) == 0 {
+		b.WriteRune('*')
+	}
+	for i, arg := range args {
+		arg := arg.(map[string]any)
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		if name := arg[funcArgNameAttr].(string); name != "" {
+			fmt.Fprint(b, name, " ")
+		}
+		b.WriteString(arg[funcArgTypeAttr].(string))
+	}
+
+	fmt.Fprint(b, ") (\n    SFUNC = ", d.Get(aggSFuncAttr).(string))
+	fmt.Fprint(b, ",\n    STYPE = ", d.Get(aggSTypeAttr).(string))
+	if v, ok := d.GetOk(aggFinalFuncAttr); ok {
+		fmt.Fprint(b, ",\n    FINALFUNC = ", v.(string))
+	}
+	if v, ok := d.GetOk(aggCombineFuncAttr); ok {
+		fmt.Fprint(b, ",\n    COMBINEFUNC = ", v.(string))
+	}
+	if v, ok := d.GetOk(aggInitCondAttr); ok {
+		fmt.Fprint(b, ",\n    INITCOND = ", pq.QuoteLiteral(v.(string)))
+	}
+	if v, ok := d.GetOk(aggParallelAttr); ok && v.(string) != "UNSAFE" {
+		fmt.Fprint(b, ",\n    PARALLEL = ", v.(string))
+	}
+	b.WriteString("\n)")
+
+	return b.String()
+}
+
+func getAggregateSchema(d *schema.ResourceData) string {
+	if v, ok := d.GetOk(aggSchemaAttr); ok {
+		return v.(string)
+	}
+	return "public"
+}
+
+// generateAggregateID returns the ID database.schema.name(argtypes), the same format as the functions.
+func generateAggregateID(d *schema.ResourceData, database string) string {
+	b := bytes.NewBufferString("")
+	fmt.Fprint(b, database, ".", getAggregateSchema(d), ".", d.Get(aggNameAttr).(string), "(")
+
+	for i, arg := range d.Get(aggArgAttr).([]any) {
+		if i > 0 {
+			b.WriteRune(',')
+		}
+		b.WriteString(arg.(map[string]any)[funcArgTypeAttr].(string))
+	}
+
+	b.WriteRune(')')
+
+	return b.String()
+}
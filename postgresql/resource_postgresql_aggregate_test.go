@@ -0,0 +1,295 @@
+package postgresql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccPostgresqlAggregate_Basic(t *testing.T) {
+	config := `
+resource "postgresql_aggregate" "basic_aggregate" {
+    name = "basic_sum"
+    arg {
+        type = "int4"
+    }
+    sfunc       = "int4pl"
+    stype       = "int4"
+    combinefunc = "int4pl"
+    initcond    = "0"
+    parallel    = "SAFE"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureAggregateParallel)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlAggregateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlAggregateExists("postgresql_aggregate.basic_aggregate"),
+					resource.TestCheckResourceAttr(
+						"postgresql_aggregate.basic_aggregate", "schema", "public"),
+					// The configured type aliases are kept
+					resource.TestCheckResourceAttr(
+						"postgresql_aggregate.basic_aggregate", "arg.0.type", "int4"),
+					resource.TestCheckResourceAttr(
+						"postgresql_aggregate.basic_aggregate", "stype", "int4"),
+					resource.TestCheckResourceAttr(
+						"postgresql_aggregate.basic_aggregate", "sfunc", "int4pl"),
+					resource.TestCheckResourceAttr(
+						"postgresql_aggregate.basic_aggregate", "combinefunc", "int4pl"),
+					resource.TestCheckResourceAttr(
+						"postgresql_aggregate.basic_aggregate", "finalfunc", ""),
+					resource.TestCheckResourceAttr(
+						"postgresql_aggregate.basic_aggregate", "initcond", "0"),
+					resource.TestCheckResourceAttr(
+						"postgresql_aggregate.basic_aggregate", "parallel", "SAFE"),
+				),
+			},
+			{
+				ResourceName:      "postgresql_aggregate.basic_aggregate",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported from the catalog, the types are not aliased
+				ImportStateVerifyIgnore: []string{aggDropCascadeAttr, "arg.0.type", aggSTypeAttr},
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlAggregate_FinalFunc(t *testing.T) {
+	config := `
+resource "postgresql_function" "avg_final" {
+    name = "test_avg_final"
+    arg {
+        type = "numeric[]"
+    }
+    returns  = "numeric"
+    language = "sql"
+    body     = "SELECT CASE WHEN $1[1] = 0 THEN NULL ELSE $1[2] / $1[1] END"
+}
+
+resource "postgresql_function" "avg_accum" {
+    name = "test_avg_accum"
+    arg {
+        type = "numeric[]"
+    }
+    arg {
+        type = "numeric"
+    }
+    returns  = "numeric[]"
+    language = "sql"
+    body     = "SELECT ARRAY[$1[1] + 1, $1[2] + $2]"
+}
+
+resource "postgresql_aggregate" "avg" {
+    name = "test_avg"
+    arg {
+        name = "value"
+        type = "numeric"
+    }
+    sfunc     = postgresql_function.avg_accum.name
+    stype     = "numeric[]"
+    finalfunc = postgresql_function.avg_final.name
+    initcond  = "{0,0}"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlAggregateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlAggregateExists("postgresql_aggregate.avg"),
+					resource.TestCheckResourceAttr(
+						"postgresql_aggregate.avg", "arg.0.name", "value"),
+					resource.TestCheckResourceAttr(
+						"postgresql_aggregate.avg", "arg.0.type", "numeric"),
+					resource.TestCheckResourceAttr(
+						"postgresql_aggregate.avg", "sfunc", "test_avg_accum"),
+					resource.TestCheckResourceAttr(
+						"postgresql_aggregate.avg", "finalfunc", "test_avg_final"),
+					resource.TestCheckResourceAttr(
+						"postgresql_aggregate.avg", "initcond", "{0,0}"),
+					resource.TestCheckResourceAttr(
+						"postgresql_aggregate.avg", "parallel", "UNSAFE"),
+				),
+			},
+			{
+				ResourceName:            "postgresql_aggregate.avg",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{aggDropCascadeAttr},
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlAggregate_NoArgs(t *testing.T) {
+	config := `
+resource "postgresql_aggregate" "count" {
+    name     = "test_count_rows"
+    sfunc    = "int8inc"
+    stype    = "int8"
+    initcond = "0"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlAggregateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlAggregateExists("postgresql_aggregate.count"),
+					resource.TestCheckResourceAttr(
+						"postgresql_aggregate.count", "arg.#", "0"),
+					resource.TestCheckResourceAttr(
+						"postgresql_aggregate.count", "sfunc", "int8inc"),
+					resource.TestCheckResourceAttr(
+						"postgresql_aggregate.count", "initcond", "0"),
+				),
+			},
+			{
+				ResourceName:            "postgresql_aggregate.count",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{aggDropCascadeAttr, aggSTypeAttr},
+			},
+		},
+	})
+}
+
+func testAccCheckPostgresqlAggregateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		exists, err := checkAggregateExists(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking aggregate %s", err)
+		}
+
+		if !exists {
+			return fmt.Errorf("Aggregate not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPostgresqlAggregateDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "postgresql_aggregate" {
+			continue
+		}
+
+		exists, err := checkAggregateExists(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking aggregate %s", err)
+		}
+
+		if exists {
+			return fmt.Errorf("Aggregate still exists after destroy")
+		}
+	}
+
+	return nil
+}
+
+func checkAggregateExists(aggregateID string) (bool, error) {
+	client := testAccProvider.Meta().(*Client)
+
+	databaseName, signature, err := expandFunctionID(aggregateID, nil, nil)
+	if err != nil {
+		return false, err
+	}
+
+	txn, err := startTransaction(client, databaseName)
+	if err != nil {
+		return false, err
+	}
+	defer deferredRollback(txn)
+
+	var exists bool
+	if err := txn.QueryRow(
+		"SELECT EXISTS (SELECT 1 FROM pg_catalog.pg_aggregate WHERE aggfnoid::oid = to_regprocedure($1))", signature,
+	).Scan(&exists); err != nil {
+		return false, fmt.Errorf("error reading info about aggregate: %w", err)
+	}
+
+	return exists, nil
+}
+
+func TestCreateAggregateQuery(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourcePostgreSQLAggregate().Schema, map[string]any{
+		"name":        "my_sum",
+		"schema":      "analytics",
+		"sfunc":       "int8pl",
+		"stype":       "bigint",
+		"combinefunc": "int8pl",
+		"initcond":    "0",
+		"parallel":    "SAFE",
+		"arg": []any{
+			map[string]any{"name": "value", "type": "bigint"},
+		},
+	})
+
+	assert.Equal(t, `CREATE AGGREGATE "analytics"."my_sum" (value bigint) (
+    SFUNC = int8pl,
+    STYPE = bigint,
+    COMBINEFUNC = int8pl,
+    INITCOND = '0',
+    PARALLEL = SAFE
+)`, createAggregateQuery(d))
+	assert.Equal(t, "mydb.analytics.my_sum(bigint)", generateAggregateID(d, "mydb"))
+
+	d = schema.TestResourceDataRaw(t, resourcePostgreSQLAggregate().Schema, map[string]any{
+		"name":      "my_count",
+		"sfunc":     "int8inc",
+		"stype":     "int8",
+		"finalfunc": "my_final",
+	})
+
+	assert.Equal(t, `CREATE AGGREGATE "public"."my_count" (*) (
+    SFUNC = int8inc,
+    STYPE = int8,
+    FINALFUNC = my_final
+)`, createAggregateQuery(d))
+	assert.Equal(t, "mydb.public.my_count()", generateAggregateID(d, "mydb"))
+}
+
+func TestDropAggregateQuery(t *testing.T) {
+	_, signature, err := expandFunctionID("mydb.public.my_count()", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `DROP AGGREGATE IF EXISTS "public"."my_count"(*) RESTRICT`, dropAggregateQuery(signature, "RESTRICT"))
+
+	_, signature, err = expandFunctionID("mydb.analytics.my_sum(bigint)", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `DROP AGGREGATE IF EXISTS "analytics"."my_sum"(bigint) CASCADE`, dropAggregateQuery(signature, "CASCADE"))
+}
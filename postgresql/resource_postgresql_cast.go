@@ -0,0 +1,285 @@
+package postgresql
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	castSourceTypeAttr  = "source_type"
+	castTargetTypeAttr  = "target_type"
+	castDatabaseAttr    = "database"
+	castFunctionAttr    = "function"
+	castInoutAttr       = "inout"
+	castContextAttr     = "context"
+	castDropCascadeAttr = "drop_cascade"
+)
+
+var castContexts = map[string]string{
+	"e": "EXPLICIT",
+	"a": "ASSIGNMENT",
+	"i": "IMPLICIT",
+}
+
+func resourcePostgreSQLCast() *schema.Resource {
+	return &schema.Resource{
+		Create: PGResourceFunc(resourcePostgreSQLCastCreate),
+		Read:   PGResourceFunc(resourcePostgreSQLCastRead),
+		Update: PGResourceFunc(resourcePostgreSQLCastUpdate),
+		Delete: PGResourceFunc(resourcePostgreSQLCastDelete),
+		Exists: PGResourceExistsFunc(resourcePostgreSQLCastExists),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			castSourceTypeAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The source data type of the cast.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			castTargetTypeAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The target data type of the cast.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			castDatabaseAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The database where the cast is located. If not specified, the provider default database is used.",
+			},
+			castFunctionAttr: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The function used to perform the cast, e.g. my_function(integer)",
+				ConflictsWith: []string{castInoutAttr},
+			},
+			castInoutAttr: {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				Default:       false,
+				Description:   "If true, the cast is performed by the output function of the source type and the input function of the target type",
+				ConflictsWith: []string{castFunctionAttr},
+			},
+			castContextAttr: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "EXPLICIT",
+				Description:  "The contexts in which the cast can be invoked implicitly. One of: EXPLICIT, ASSIGNMENT, IMPLICIT",
+				ValidateFunc: validation.StringInSlice([]string{"EXPLICIT", "ASSIGNMENT", "IMPLICIT"}, false),
+			},
+			castDropCascadeAttr: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Automatically drop objects that depend on the cast, and in turn all objects that depend on those objects.",
+			},
+		},
+	}
+}
+
+func resourcePostgreSQLCastCreate(db *DBConnection, d *schema.ResourceData) error {
+	database := getDatabase(d, db.client.databaseName)
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if _, err := txn.Exec(createCastQuery(d)); err != nil {
+		return fmt.Errorf("error creating cast: %w", err)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing cast: %w", err)
+	}
+
+	d.SetId(generateCastID(d, database))
+
+	return resourcePostgreSQLCastReadImpl(db, d)
+}
+
+func resourcePostgreSQLCastExists(db *DBConnection, d *schema.ResourceData) (bool, error) {
+	database, sourceType, targetType, err := expandCastID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return false, err
+	}
+	defer deferredRollback(txn)
+
+	var castExists bool
+	if err := txn.QueryRow(
+		"SELECT EXISTS (SELECT 1 FROM pg_catalog.pg_cast WHERE castsource = to_regtype($1) AND casttarget = to_regtype($2))",
+		sourceType, targetType,
+	).Scan(&castExists); err != nil {
+		return false, fmt.Errorf("could not check if cast exists: %w", err)
+	}
+
+	return castExists, nil
+}
+
+func resourcePostgreSQLCastRead(db *DBConnection, d *schema.ResourceData) error {
+	return resourcePostgreSQLCastReadImpl(db, d)
+}
+
+func resourcePostgreSQLCastReadImpl(db *DBConnection, d *schema.ResourceData) error {
+	database, sourceType, targetType, err := expandCastID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	query := `SELECT c.castsource::regtype::text, c.casttarget::regtype::text, ` +
+		`CASE WHEN c.castfunc = 0 THEN '' ELSE c.castfunc::regprocedure::text END, ` +
+		`c.castcontext, c.castmethod ` +
+		`FROM pg_catalog.pg_cast c ` +
+		`WHERE c.castsource = to_regtype($1) AND c.casttarget = to_regtype($2)`
+
+	var source, target, function, context, method string
+	err = txn.QueryRow(query, sourceType, targetType).Scan(&source, &target, &function, &context, &method)
+	switch {
+	case err == sql.ErrNoRows:
+		log.Printf("[WARN] PostgreSQL cast (%s AS %s) not found in database %s", sourceType, targetType, database)
+		d.SetId("")
+		return nil
+	case err != nil:
+		return fmt.Errorf("error reading cast: %w", err)
+	}
+
+	if source, err = resolveCatalogName(txn, "regtype", sourceType, source); err != nil {
+		return err
+	}
+	if target, err = resolveCatalogName(txn, "regtype", targetType, target); err != nil {
+		return err
+	}
+	if function, err = resolveCastFunction(txn, d.Get(castFunctionAttr).(string), function); err != nil {
+		return err
+	}
+
+	d.Set(castDatabaseAttr, database)
+	d.Set(castSourceTypeAttr, source)
+	d.Set(castTargetTypeAttr, target)
+	d.Set(castFunctionAttr, function)
+	d.Set(castInoutAttr, method == "i")
+	d.Set(castContextAttr, castContexts[context])
+
+	return nil
+}
+
+// resolveCastFunction keeps the configured function of the cast if it is the one read from the catalog,
+// the argument types of the function can be omitted in the configuration.
+func resolveCastFunction(txn *sql.Tx, configured, function string) (string, error) {
+	if strings.Contains(configured, "(") {
+		return resolveCatalogName(txn, "regprocedure", configured, function)
+	}
+
+	functionName := strings.SplitN(function, "(", 2)[0]
+	resolved, err := resolveCatalogName(txn, "regproc", configured, functionName)
+	if err != nil {
+		return "", err
+	}
+	if configured != "" && resolved == configured {
+		return configured, nil
+	}
+	return function, nil
+}
+
+// resourcePostgreSQLCastUpdate only handles drop_cascade, all the other attributes force a new cast.
+func resourcePostgreSQLCastUpdate(db *DBConnection, d *schema.ResourceData) error {
+	return resourcePostgreSQLCastReadImpl(db, d)
+}
+
+func resourcePostgreSQLCastDelete(db *DBConnection, d *schema.ResourceData) error {
+	database, sourceType, targetType, err := expandCastID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	dropMode := "RESTRICT"
+	if d.Get(castDropCascadeAttr).(bool) {
+		dropMode = "CASCADE"
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if _, err := txn.Exec(fmt.Sprintf("DROP CAST IF EXISTS (%s AS %s) %s", sourceType, targetType, dropMode)); err != nil {
+		return fmt.Errorf("error deleting cast: %w", err)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing cast: %w", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func createCastQuery(d *schema.ResourceData) string {
+	b := bytes.NewBufferString("CREATE CAST (")
+	fmt.Fprint(b, d.Get(castSourceTypeAttr).(string), " AS ", d.Get(castTargetTypeAttr).(string), ")")
+
+	switch {
+	case d.Get(castFunctionAttr).(string) != "":
+		fmt.Fprint(b, " WITH FUNCTION ", d.Get(castFunctionAttr).(string))
+	case d.Get(castInoutAttr).(bool):
+		b.WriteString(" WITH INOUT")
+	default:
+		b.WriteString(" WITHOUT FUNCTION")
+	}
+
+	if context := d.Get(castContextAttr).(string); context != "" && context != "EXPLICIT" {
+		fmt.Fprint(b, " AS ", context)
+	}
+
+	return b.String()
+}
+
+// generateCastID returns the ID database.(source_type AS target_type).
+// Casts don't belong to a schema and the types can be schema qualified.
+func generateCastID(d *schema.ResourceData, database string) string {
+	return fmt.Sprintf(
+		"%s.(%s AS %s)", database, d.Get(castSourceTypeAttr).(string), d.Get(castTargetTypeAttr).(string),
+	)
+}
+
+// expandCastID returns the database, the source type and the target type of a cast from its ID.
+func expandCastID(castID string) (string, string, string, error) {
+	parts := findStringSubmatchMap(`^(?P<Database>[^.]+)\.\((?P<Source>.+) AS (?P<Target>.+)\)$`, castID)
+	if len(parts) == 0 || parts["Database"] == "" {
+		return "", "", "", fmt.Errorf(
+			"cast ID %s has not the expected format 'database.(source_type AS target_type)'", castID,
+		)
+	}
+
+	return parts["Database"], parts["Source"], parts["Target"], nil
+}
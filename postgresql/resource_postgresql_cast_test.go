@@ -0,0 +1,224 @@
+package postgresql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAccPostgresqlCastConfig = `
+resource "postgresql_function" "from_int" {
+    database = "%[1]s"
+    name     = "test_cast_from_int"
+    arg {
+        type = "integer"
+    }
+    returns  = "test_cast_type"
+    language = "sql"
+    body     = "SELECT ROW($1)::test_cast_type"
+}
+
+resource "postgresql_cast" "from_int" {
+    database    = "%[1]s"
+    source_type = "int4"
+    target_type = "test_cast_type"
+    function    = "test_cast_from_int(int4)"
+    context     = "ASSIGNMENT"
+
+    depends_on = [postgresql_function.from_int]
+}
+
+resource "postgresql_cast" "to_text" {
+    database    = "%[1]s"
+    source_type = "test_cast_type"
+    target_type = "text"
+    inout       = true
+}
+`
+
+func TestAccPostgresqlCast_Basic(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	dbName, _ := getTestDBNames(dbSuffix)
+	config := getTestConfig(t)
+	dbExecute(t, config.connStr(dbName), "CREATE TYPE test_cast_type AS (value integer)")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlCastDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccPostgresqlCastConfig, dbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlCastExists("postgresql_cast.from_int"),
+					testAccCheckPostgresqlCastExists("postgresql_cast.to_text"),
+					resource.TestCheckResourceAttr(
+						"postgresql_cast.from_int", "id", fmt.Sprintf("%s.(int4 AS test_cast_type)", dbName)),
+					resource.TestCheckResourceAttr("postgresql_cast.from_int", "source_type", "int4"),
+					resource.TestCheckResourceAttr("postgresql_cast.from_int", "target_type", "test_cast_type"),
+					resource.TestCheckResourceAttr("postgresql_cast.from_int", "function", "test_cast_from_int(int4)"),
+					resource.TestCheckResourceAttr("postgresql_cast.from_int", "inout", "false"),
+					resource.TestCheckResourceAttr("postgresql_cast.from_int", "context", "ASSIGNMENT"),
+					resource.TestCheckResourceAttr("postgresql_cast.to_text", "function", ""),
+					resource.TestCheckResourceAttr("postgresql_cast.to_text", "inout", "true"),
+					resource.TestCheckResourceAttr("postgresql_cast.to_text", "context", "EXPLICIT"),
+				),
+			},
+			{
+				ResourceName:            "postgresql_cast.to_text",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{castDropCascadeAttr},
+			},
+			{
+				ResourceName:      "postgresql_cast.from_int",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s.(integer AS test_cast_type)", dbName),
+				ImportStateVerify: true,
+				// The ID and the types are imported as named in the catalog
+				ImportStateVerifyIgnore: []string{castDropCascadeAttr, "id", castSourceTypeAttr, castFunctionAttr},
+			},
+		},
+	})
+}
+
+func testAccCheckPostgresqlCastExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		exists, err := checkCastExists(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking cast %s", err)
+		}
+
+		if !exists {
+			return fmt.Errorf("Cast not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPostgresqlCastDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "postgresql_cast" {
+			continue
+		}
+
+		exists, err := checkCastExists(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking cast %s", err)
+		}
+
+		if exists {
+			return fmt.Errorf("Cast still exists after destroy")
+		}
+	}
+
+	return nil
+}
+
+func checkCastExists(castID string) (bool, error) {
+	client := testAccProvider.Meta().(*Client)
+
+	databaseName, sourceType, targetType, err := expandCastID(castID)
+	if err != nil {
+		return false, err
+	}
+
+	txn, err := startTransaction(client, databaseName)
+	if err != nil {
+		return false, err
+	}
+	defer deferredRollback(txn)
+
+	var exists bool
+	if err := txn.QueryRow(
+		"SELECT EXISTS (SELECT 1 FROM pg_catalog.pg_cast WHERE castsource = to_regtype($1) AND casttarget = to_regtype($2))",
+		sourceType, targetType,
+	).Scan(&exists); err != nil {
+		return false, fmt.Errorf("error reading info about cast: %w", err)
+	}
+
+	return exists, nil
+}
+
+func TestCreateCastQuery(t *testing.T) {
+	cases := []struct {
+		name     string
+		values   map[string]any
+		expected string
+	}{
+		{
+			name: "with function",
+			values: map[string]any{
+				"source_type": "integer",
+				"target_type": "app.money",
+				"function":    "app.money_from_int(integer)",
+				"context":     "IMPLICIT",
+			},
+			expected: "CREATE CAST (integer AS app.money) WITH FUNCTION app.money_from_int(integer) AS IMPLICIT",
+		},
+		{
+			name: "with inout",
+			values: map[string]any{
+				"source_type": "app.money",
+				"target_type": "text",
+				"inout":       true,
+				"context":     "ASSIGNMENT",
+			},
+			expected: "CREATE CAST (app.money AS text) WITH INOUT AS ASSIGNMENT",
+		},
+		{
+			name: "without function",
+			values: map[string]any{
+				"source_type": "app.id",
+				"target_type": "bigint",
+			},
+			expected: "CREATE CAST (app.id AS bigint) WITHOUT FUNCTION",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourcePostgreSQLCast().Schema, c.values)
+			assert.Equal(t, c.expected, createCastQuery(d))
+		})
+	}
+}
+
+func TestExpandCastID(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourcePostgreSQLCast().Schema, map[string]any{
+		"source_type": "double precision",
+		"target_type": "app.money",
+	})
+
+	id := generateCastID(d, "mydb")
+	assert.Equal(t, "mydb.(double precision AS app.money)", id)
+
+	database, sourceType, targetType, err := expandCastID(id)
+	assert.NoError(t, err)
+	assert.Equal(t, "mydb", database)
+	assert.Equal(t, "double precision", sourceType)
+	assert.Equal(t, "app.money", targetType)
+
+	_, _, _, err = expandCastID("mydb.integer.text")
+	assert.Error(t, err)
+}
@@ -24,9 +24,24 @@ const (
 	dbOwnerAttr            = "owner"
 	dbTablespaceAttr       = "tablespace_name"
 	dbTemplateAttr         = "template"
+	dbParametersAttr       = "parameters"
 	dbAlterObjectOwnership = "alter_object_ownership"
+
+	dbLocaleProviderAttr          = "locale_provider"
+	dbICULocaleAttr               = "icu_locale"
+	dbICURulesAttr                = "icu_rules"
+	dbBuiltinLocaleAttr           = "builtin_locale"
+	dbCollationVersionAttr        = "collation_version"
+	dbRefreshCollationVersionAttr = "refresh_collation_version"
 )
 
+// dbLocaleProviders maps the values of pg_database.datlocprovider to the provider names.
+var dbLocaleProviders = map[string]string{
+	"b": "builtin",
+	"c": "libc",
+	"i": "icu",
+}
+
 func resourcePostgreSQLDatabase() *schema.Resource {
 	return &schema.Resource{
 		Create: PGResourceFunc(resourcePostgreSQLDatabaseCreate),
@@ -109,15 +124,69 @@ func resourcePostgreSQLDatabase() *schema.Resource {
 				Default:     false,
 				Description: "If true, the owner of already existing objects will change if the owner changes",
 			},
+			dbParametersAttr: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Configuration parameters set for the database (ALTER DATABASE ... SET), e.g. timezone or statement_timeout",
+			},
+			dbLocaleProviderAttr: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "Locale provider to use in the new database (libc, icu or builtin)",
+				ValidateFunc: validation.StringInSlice([]string{"libc", "icu", "builtin"}, false),
+			},
+			dbICULocaleAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ICU locale to use in the new database when the locale provider is icu",
+			},
+			dbICURulesAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Additional collation rules to customize the ICU collation of the new database",
+			},
+			dbBuiltinLocaleAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Locale to use in the new database when the locale provider is builtin",
+			},
+			dbCollationVersionAttr: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the collation recorded when the database was created or last refreshed",
+			},
+			dbRefreshCollationVersionAttr: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the collation version of the database is refreshed when it does not match the version provided by the operating system",
+			},
 		},
 	}
 }
 
 func resourcePostgreSQLDatabaseCreate(db *DBConnection, d *schema.ResourceData) error {
+	if err := validateDBLocaleOptions(db, d); err != nil {
+		return err
+	}
+
 	if err := createDatabase(db, d); err != nil {
 		return err
 	}
 
+	if err := setDBParameters(db, d); err != nil {
+		return err
+	}
+
 	d.SetId(d.Get(dbNameAttr).(string))
 
 	return resourcePostgreSQLDatabaseReadImpl(db, d)
@@ -204,6 +273,21 @@ func createDatabase(db *DBConnection, d *schema.ResourceData) error {
 		fmt.Fprintf(b, " LC_CTYPE '%s' ", pqQuoteLiteral(v.(string)))
 	}
 
+	// Locale provider options are only specified if set by the user,
+	// their support has been checked by validateDBLocaleOptions.
+	if v, ok := d.GetOk(dbLocaleProviderAttr); ok {
+		fmt.Fprint(b, " LOCALE_PROVIDER ", v.(string))
+	}
+	if v, ok := d.GetOk(dbICULocaleAttr); ok {
+		fmt.Fprint(b, " ICU_LOCALE ", pq.QuoteLiteral(v.(string)))
+	}
+	if v, ok := d.GetOk(dbICURulesAttr); ok {
+		fmt.Fprint(b, " ICU_RULES ", pq.QuoteLiteral(v.(string)))
+	}
+	if v, ok := d.GetOk(dbBuiltinLocaleAttr); ok {
+		fmt.Fprint(b, " BUILTIN_LOCALE ", pq.QuoteLiteral(v.(string)))
+	}
+
 	switch v, ok := d.GetOk(dbTablespaceAttr); {
 	case ok && strings.ToUpper(v.(string)) == "DEFAULT":
 		fmt.Fprint(b, " TABLESPACE DEFAULT")
@@ -392,9 +476,88 @@ func resourcePostgreSQLDatabaseReadImpl(db *DBConnection, d *schema.ResourceData
 		d.Set(dbIsTemplateAttr, dbIsTemplate)
 	}
 
+	var dbConfig pq.ByteaArray
+	err = db.QueryRow(
+		"SELECT s.setconfig FROM pg_catalog.pg_db_role_setting AS s "+
+			"JOIN pg_catalog.pg_database AS d ON d.oid = s.setdatabase "+
+			"WHERE d.datname = $1 AND s.setrole = 0",
+		dbId,
+	).Scan(&dbConfig)
+	switch {
+	case err == sql.ErrNoRows:
+		dbConfig = nil
+	case err != nil:
+		return fmt.Errorf("error reading database parameters: %w", err)
+	}
+
+	d.Set(dbParametersAttr, readParameters(dbConfig))
+
+	if db.featureSupported(featureDBLocaleProvider) {
+		if err := readDBLocale(db, d, dbId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readDBLocale reads the locale provider, the provider specific locale and the collation version of the database.
+func readDBLocale(db *DBConnection, d *schema.ResourceData, dbName string) error {
+	// daticulocale has been renamed to datlocale in Postgres 17 as it's also used by the builtin provider.
+	localeColumn := "d.daticulocale"
+	if db.featureSupported(featureDBBuiltinLocaleProvider) {
+		localeColumn = "d.datlocale"
+	}
+	rulesColumn := "NULL"
+	if db.featureSupported(featureDBICURules) {
+		rulesColumn = "d.daticurules"
+	}
+
+	var provider string
+	var locale, rules, collationVersion, actualCollationVersion sql.NullString
+	query := fmt.Sprintf(
+		"SELECT d.datlocprovider, %s, %s, d.datcollversion, pg_catalog.pg_database_collation_actual_version(d.oid) "+
+			"FROM pg_catalog.pg_database AS d WHERE d.datname = $1",
+		localeColumn, rulesColumn,
+	)
+	if err := db.QueryRow(query, dbName).Scan(&provider, &locale, &rules, &collationVersion, &actualCollationVersion); err != nil {
+		return fmt.Errorf("error reading locale provider of database %s: %w", dbName, err)
+	}
+
+	providerName := dbLocaleProviders[provider]
+	d.Set(dbLocaleProviderAttr, providerName)
+	d.Set(dbICURulesAttr, rules.String)
+	d.Set(dbCollationVersionAttr, collationVersion.String)
+
+	switch providerName {
+	case "icu":
+		d.Set(dbICULocaleAttr, locale.String)
+		d.Set(dbBuiltinLocaleAttr, "")
+	case "builtin":
+		d.Set(dbICULocaleAttr, "")
+		d.Set(dbBuiltinLocaleAttr, locale.String)
+	default:
+		d.Set(dbICULocaleAttr, "")
+		d.Set(dbBuiltinLocaleAttr, "")
+	}
+
+	// If the collation version is stale, we mark the refresh as not done
+	// so the plan will show a change and Update will refresh it.
+	if d.Get(dbRefreshCollationVersionAttr).(bool) && isDBCollationVersionStale(collationVersion, actualCollationVersion) {
+		log.Printf(
+			"[WARN] PostgreSQL database (%s) collation version %s does not match the actual version %s",
+			dbName, collationVersion.String, actualCollationVersion.String,
+		)
+		d.Set(dbRefreshCollationVersionAttr, false)
+	}
+
 	return nil
 }
 
+func isDBCollationVersionStale(collationVersion, actualCollationVersion sql.NullString) bool {
+	return collationVersion.Valid && actualCollationVersion.Valid && collationVersion.String != actualCollationVersion.String
+}
+
 func resourcePostgreSQLDatabaseUpdate(db *DBConnection, d *schema.ResourceData) error {
 	if err := setDBName(db, d); err != nil {
 		return err
@@ -424,7 +587,13 @@ func resourcePostgreSQLDatabaseUpdate(db *DBConnection, d *schema.ResourceData)
 		return err
 	}
 
-	// Empty values: ALTER DATABASE name RESET configuration_parameter;
+	if err := setDBParameters(db, d); err != nil {
+		return err
+	}
+
+	if err := setDBCollationVersion(db, d); err != nil {
+		return err
+	}
 
 	return resourcePostgreSQLDatabaseReadImpl(db, d)
 }
@@ -578,6 +747,75 @@ func setDBConnLimit(db QueryAble, d *schema.ResourceData) error {
 	return nil
 }
 
+// validateDBLocaleOptions checks that the locale provider options set by the user
+// are supported by the Postgres version.
+func validateDBLocaleOptions(db *DBConnection, d *schema.ResourceData) error {
+	for _, attr := range []string{dbLocaleProviderAttr, dbICULocaleAttr} {
+		if _, ok := d.GetOk(attr); ok && !db.featureSupported(featureDBLocaleProvider) {
+			return fmt.Errorf("%s is not supported for this Postgres version (%s)", attr, db.version)
+		}
+	}
+
+	if _, ok := d.GetOk(dbICURulesAttr); ok && !db.featureSupported(featureDBICURules) {
+		return fmt.Errorf("%s is not supported for this Postgres version (%s)", dbICURulesAttr, db.version)
+	}
+
+	_, builtinLocaleSet := d.GetOk(dbBuiltinLocaleAttr)
+	if (builtinLocaleSet || d.Get(dbLocaleProviderAttr).(string) == "builtin") && !db.featureSupported(featureDBBuiltinLocaleProvider) {
+		return fmt.Errorf("builtin locale provider is not supported for this Postgres version (%s)", db.version)
+	}
+
+	return nil
+}
+
+// setDBCollationVersion refreshes the collation version of the database if it's stale
+// and the refresh has been enabled.
+func setDBCollationVersion(db *DBConnection, d *schema.ResourceData) error {
+	if !d.HasChange(dbRefreshCollationVersionAttr) || !d.Get(dbRefreshCollationVersionAttr).(bool) {
+		return nil
+	}
+
+	if !db.featureSupported(featureDBCollationVersion) {
+		return fmt.Errorf("%s is not supported for this Postgres version (%s)", dbRefreshCollationVersionAttr, db.version)
+	}
+
+	dbName := d.Get(dbNameAttr).(string)
+	var collationVersion, actualCollationVersion sql.NullString
+	err := db.QueryRow(
+		"SELECT datcollversion, pg_catalog.pg_database_collation_actual_version(oid) FROM pg_catalog.pg_database WHERE datname = $1",
+		dbName,
+	).Scan(&collationVersion, &actualCollationVersion)
+	if err != nil {
+		return fmt.Errorf("error reading collation version of database %s: %w", dbName, err)
+	}
+
+	if !isDBCollationVersionStale(collationVersion, actualCollationVersion) {
+		return nil
+	}
+
+	sql := fmt.Sprintf("ALTER DATABASE %s REFRESH COLLATION VERSION", pq.QuoteIdentifier(dbName))
+	if _, err := db.Exec(sql); err != nil {
+		return fmt.Errorf("error refreshing collation version of database %s: %w", dbName, err)
+	}
+
+	return nil
+}
+
+// setDBParameters resets the parameters removed from the configuration and sets the new or modified ones.
+func setDBParameters(db QueryAble, d *schema.ResourceData) error {
+	if !d.HasChange(dbParametersAttr) {
+		return nil
+	}
+
+	oraw, nraw := d.GetChange(dbParametersAttr)
+	target := fmt.Sprintf("DATABASE %s", pq.QuoteIdentifier(d.Get(dbNameAttr).(string)))
+	if err := alterParameters(db, target, oraw.(map[string]any), nraw.(map[string]any)); err != nil {
+		return fmt.Errorf("error updating database parameters: %w", err)
+	}
+
+	return nil
+}
+
 func setDBAllowConns(db *DBConnection, d *schema.ResourceData) error {
 	if !d.HasChange(dbAllowConnsAttr) {
 		return nil
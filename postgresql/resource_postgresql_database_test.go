@@ -187,6 +187,75 @@ resource postgresql_database test_db {
 					),
 				),
 			},
+			{
+				Config: `
+resource postgresql_database test_db {
+	name = "test_db"
+	parameters = {
+		timezone          = "UTC"
+		statement_timeout = "30s"
+		search_path       = "foo, public"
+	}
+}
+	`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlDatabaseExists("postgresql_database.test_db"),
+					resource.TestCheckResourceAttr("postgresql_database.test_db", "parameters.%", "3"),
+					resource.TestCheckResourceAttr("postgresql_database.test_db", "parameters.timezone", "UTC"),
+					resource.TestCheckResourceAttr("postgresql_database.test_db", "parameters.statement_timeout", "30s"),
+					resource.TestCheckResourceAttr("postgresql_database.test_db", "parameters.search_path", "foo, public"),
+				),
+			},
+			{
+				Config: `
+resource postgresql_database test_db {
+	name = "test_db"
+	parameters = {
+		timezone = "Europe/Paris"
+	}
+}
+	`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlDatabaseExists("postgresql_database.test_db"),
+					resource.TestCheckResourceAttr("postgresql_database.test_db", "parameters.%", "1"),
+					resource.TestCheckResourceAttr("postgresql_database.test_db", "parameters.timezone", "Europe/Paris"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlDatabase_ICULocale(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureDBLocaleProvider)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource postgresql_database icu_db {
+	name            = "tf_tests_icu_db"
+	template        = "template0"
+	locale_provider = "icu"
+	icu_locale      = "en-US"
+	lc_collate      = "C"
+	lc_ctype        = "C"
+
+	refresh_collation_version = true
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlDatabaseExists("postgresql_database.icu_db"),
+					resource.TestCheckResourceAttr("postgresql_database.icu_db", "locale_provider", "icu"),
+					resource.TestCheckResourceAttr("postgresql_database.icu_db", "icu_locale", "en-US"),
+					resource.TestCheckResourceAttr("postgresql_database.icu_db", "builtin_locale", ""),
+					resource.TestCheckResourceAttrSet("postgresql_database.icu_db", "collation_version"),
+					resource.TestCheckResourceAttr("postgresql_database.icu_db", "refresh_collation_version", "true"),
+				),
+			},
 		},
 	})
 }
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,12 +14,39 @@ import (
 	"github.com/lib/pq"
 )
 
+// defaultPrivilegesObjects maps the object types to the keyword used
+// in ALTER DEFAULT PRIVILEGES ... ON <objects>.
+var defaultPrivilegesObjects = map[string]string{
+	"table":        "TABLES",
+	"sequence":     "SEQUENCES",
+	"function":     "FUNCTIONS",
+	"routine":      "ROUTINES",
+	"type":         "TYPES",
+	"schema":       "SCHEMAS",
+	"large_object": "LARGE OBJECTS",
+}
+
+// aclDefaultObjectTypes maps the object types to the type code expected by acldefault(),
+// which differs from defaclobjtype for sequences ('S' being foreign servers for acldefault).
+var aclDefaultObjectTypes = map[string]string{
+	"table":        "r",
+	"sequence":     "s",
+	"function":     "f",
+	"routine":      "f",
+	"type":         "T",
+	"schema":       "n",
+	"large_object": "L",
+}
+
 func resourcePostgreSQLDefaultPrivileges() *schema.Resource {
 	return &schema.Resource{
 		Create: PGResourceFunc(resourcePostgreSQLDefaultPrivilegesCreate),
-		Update: PGResourceFunc(resourcePostgreSQLDefaultPrivilegesCreate),
+		Update: PGResourceFunc(resourcePostgreSQLDefaultPrivilegesUpdate),
 		Read:   PGResourceFunc(resourcePostgreSQLDefaultPrivilegesRead),
 		Delete: PGResourceFunc(resourcePostgreSQLDefaultPrivilegesDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: PGResourceImportFunc(resourcePostgreSQLDefaultPrivilegesImport),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role": {
@@ -34,10 +62,21 @@ func resourcePostgreSQLDefaultPrivileges() *schema.Resource {
 				Description: "The database to grant default privileges for this role",
 			},
 			"owner": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Target role for which to alter default privileges.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"owner", "owners"},
+				Description:  "Target role for which to alter default privileges.",
+			},
+			"owners": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				MinItems:     1,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Set:          schema.HashString,
+				ExactlyOneOf: []string{"owner", "owners"},
+				Description:  "Target roles for which to alter default privileges, the same privileges being managed for each of them.",
 			},
 			"schema": {
 				Type:        schema.TypeString,
@@ -56,8 +95,9 @@ func resourcePostgreSQLDefaultPrivileges() *schema.Resource {
 					"routine",
 					"type",
 					"schema",
+					"large_object",
 				}, false),
-				Description: "The PostgreSQL object type to set the default privileges on (one of: table, sequence, function, routine, type, schema)",
+				Description: "The PostgreSQL object type to set the default privileges on (one of: table, sequence, function, routine, type, schema, large_object)",
 			},
 			"privileges": {
 				Type:        schema.TypeSet,
@@ -77,22 +117,85 @@ func resourcePostgreSQLDefaultPrivileges() *schema.Resource {
 	}
 }
 
-func resourcePostgreSQLDefaultPrivilegesRead(db *DBConnection, d *schema.ResourceData) error {
-	pgSchema := d.Get("schema").(string)
-	objectType := d.Get("object_type").(string)
+// resourcePostgreSQLDefaultPrivilegesImport parses the ID generated by generateDefaultPrivilegesID,
+// i.e.: role_database_schema_owner_objecttype (schema being "noschema" for global default privileges
+// and owner being the comma separated list of owners if several are managed)
+// and reads the default privileges currently set.
+func resourcePostgreSQLDefaultPrivilegesImport(db *DBConnection, d *schema.ResourceData) error {
+	id := d.Id()
+	sep := strings.LastIndex(id, "_")
+	if sep == -1 {
+		return fmt.Errorf("default privileges ID %s has not the expected format 'role_database_schema_owner_objecttype'", id)
+	}
+
+	// Object types can contain `_` too (e.g.: large_object)
+	objectType := id[sep+1:]
+	for knownType := range defaultPrivilegesObjects {
+		if strings.HasSuffix(id, "_"+knownType) && len(knownType) > len(objectType) {
+			objectType = knownType
+			sep = len(id) - len(knownType) - 1
+		}
+	}
+	if _, ok := defaultPrivilegesObjects[objectType]; !ok {
+		return fmt.Errorf("default privileges ID %s contains an unknown object type: %s", id, objectType)
+	}
 
-	if pgSchema != "" && objectType == "schema" && !db.featureSupported(featurePrivilegesOnSchemas) {
+	parsed, err := splitImportID(id[:sep], []importIDMatcher{
+		importRoleMatcher(db, true),
+		importDatabaseMatcher(db),
+		func(matched []string, name string) (bool, error) {
+			if name == "noschema" {
+				return true, nil
+			}
+			return importSchemaMatcher(db, 1)(matched, name)
+		},
+		func(matched []string, name string) (bool, error) {
+			for _, owner := range strings.Split(name, ",") {
+				if ok, err := importRoleMatcher(db, false)(matched, owner); !ok || err != nil {
+					return false, err
+				}
+			}
+			return true, nil
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	if parsed == nil {
 		return fmt.Errorf(
-			"changing default privileges for schemas is not supported for this Postgres version (%s)",
-			db.version,
+			"default privileges ID %s has not the expected format 'role_database_schema_owner_objecttype' or does not match existing roles, database and schema",
+			id,
 		)
 	}
 
-	if objectType == "routine" && !db.featureSupported(featureRoutine) {
-		return fmt.Errorf(
-			"object type ROUTINE is not supported for this Postgres version (%s)",
-			db.version,
-		)
+	pgSchema := parsed[2]
+	if pgSchema == "noschema" {
+		pgSchema = ""
+	}
+
+	d.Set("role", parsed[0])
+	d.Set("database", parsed[1])
+	d.Set("schema", pgSchema)
+	if owners := strings.Split(parsed[3], ","); len(owners) > 1 {
+		d.Set("owners", owners)
+	} else {
+		d.Set("owner", parsed[3])
+	}
+	d.Set("object_type", objectType)
+	d.Set("with_grant_option", false)
+
+	txn, err := startTransaction(db.client, parsed[1])
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	return readRoleDefaultPrivileges(txn, d)
+}
+
+func resourcePostgreSQLDefaultPrivilegesRead(db *DBConnection, d *schema.ResourceData) error {
+	if err := validateDefaultPrivilegesFeatures(db, d); err != nil {
+		return err
 	}
 
 	exists, err := checkRoleDBSchemaExists(db, d)
@@ -114,51 +217,26 @@ func resourcePostgreSQLDefaultPrivilegesRead(db *DBConnection, d *schema.Resourc
 }
 
 func resourcePostgreSQLDefaultPrivilegesCreate(db *DBConnection, d *schema.ResourceData) error {
-	pgSchema := d.Get("schema").(string)
-	objectType := d.Get("object_type").(string)
-
-	if pgSchema != "" && objectType == "schema" {
-		if !db.featureSupported(featurePrivilegesOnSchemas) {
-			return fmt.Errorf(
-				"changing default privileges for schemas is not supported for this Postgres version (%s)",
-				db.version,
-			)
-		}
-		return fmt.Errorf("cannot specify `schema` when `object_type` is `schema`")
-	}
-
-	if objectType == "routine" && !db.featureSupported(featureRoutine) {
-		return fmt.Errorf(
-			"object type ROUTINE is not supported for this Postgres version (%s)",
-			db.version,
-		)
-	}
-
-	if d.Get("with_grant_option").(bool) && strings.ToLower(d.Get("role").(string)) == "public" {
-		return fmt.Errorf("with_grant_option cannot be true for role 'public'")
-	}
-
-	if err := validatePrivileges(d); err != nil {
+	if err := validateDefaultPrivileges(db, d); err != nil {
 		return err
 	}
 
-	database := d.Get("database").(string)
-	owner := d.Get("owner").(string)
+	owners := defaultPrivilegesOwners(d)
 
-	txn, err := startTransaction(db.client, database)
+	txn, err := startTransaction(db.client, d.Get("database").(string))
 	if err != nil {
 		return err
 	}
 	defer deferredRollback(txn)
 
-	if err := pgLockRole(txn, owner); err != nil {
+	if err := lockDefaultPrivilegesOwners(txn, owners); err != nil {
 		return err
 	}
 
 	// Needed in order to set the owner of the db if the connection user is not a superuser
-	if err := withRolesGranted(txn, []string{owner}, func() error {
+	if err := withRolesGranted(txn, owners, func() error {
 
-		// Revoke all privileges before granting otherwise reducing privileges will not work.
+		// Revoke all privileges before granting otherwise existing privileges would be kept.
 		// We just have to revoke them in the same transaction so role will not lose its privileges
 		// between revoke and grant.
 		if err = revokeRoleDefaultPrivileges(txn, d); err != nil {
@@ -188,17 +266,64 @@ func resourcePostgreSQLDefaultPrivilegesCreate(db *DBConnection, d *schema.Resou
 	return readRoleDefaultPrivileges(txn, d)
 }
 
-func resourcePostgreSQLDefaultPrivilegesDelete(db *DBConnection, d *schema.ResourceData) error {
-	owner := d.Get("owner").(string)
-	pgSchema := d.Get("schema").(string)
-	objectType := d.Get("object_type").(string)
+// resourcePostgreSQLDefaultPrivilegesUpdate only grants the added privileges and revokes
+// the removed ones for each owner, so the privileges are changed in place.
+func resourcePostgreSQLDefaultPrivilegesUpdate(db *DBConnection, d *schema.ResourceData) error {
+	if err := validateDefaultPrivileges(db, d); err != nil {
+		return err
+	}
 
-	if pgSchema != "" && objectType == "schema" && !db.featureSupported(featurePrivilegesOnSchemas) {
-		return fmt.Errorf(
-			"changing default privileges for schemas is not supported for this Postgres version (%s)",
-			db.version,
-		)
+	owners := defaultPrivilegesOwners(d)
+
+	txn, err := startTransaction(db.client, d.Get("database").(string))
+	if err != nil {
+		return err
 	}
+	defer deferredRollback(txn)
+
+	if err := lockDefaultPrivilegesOwners(txn, owners); err != nil {
+		return err
+	}
+
+	roleOID, err := getRoleOID(txn, d.Get("role").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := withRolesGranted(txn, owners, func() error {
+		for _, owner := range owners {
+			current, err := readOwnerDefaultPrivileges(txn, d, roleOID, owner)
+			if err != nil {
+				return err
+			}
+			if err := updateOwnerDefaultPrivileges(txn, d, owner, current); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return err
+	}
+
+	txn, err = startTransaction(db.client, d.Get("database").(string))
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	return readRoleDefaultPrivileges(txn, d)
+}
+
+func resourcePostgreSQLDefaultPrivilegesDelete(db *DBConnection, d *schema.ResourceData) error {
+	if err := validateDefaultPrivilegesFeatures(db, d); err != nil {
+		return err
+	}
+
+	owners := defaultPrivilegesOwners(d)
 
 	txn, err := startTransaction(db.client, d.Get("database").(string))
 	if err != nil {
@@ -206,12 +331,12 @@ func resourcePostgreSQLDefaultPrivilegesDelete(db *DBConnection, d *schema.Resou
 	}
 	defer deferredRollback(txn)
 
-	if err := pgLockRole(txn, owner); err != nil {
+	if err := lockDefaultPrivilegesOwners(txn, owners); err != nil {
 		return err
 	}
 
 	// Needed in order to set the owner of the db if the connection user is not a superuser
-	if err := withRolesGranted(txn, []string{owner}, func() error {
+	if err := withRolesGranted(txn, owners, func() error {
 		return revokeRoleDefaultPrivileges(txn, d)
 	}); err != nil {
 		return err
@@ -224,14 +349,88 @@ func resourcePostgreSQLDefaultPrivilegesDelete(db *DBConnection, d *schema.Resou
 	return nil
 }
 
+// validateDefaultPrivilegesFeatures checks that the object type is supported by the
+// Postgres version and can be used with the configured schema.
+func validateDefaultPrivilegesFeatures(db *DBConnection, d *schema.ResourceData) error {
+	pgSchema := d.Get("schema").(string)
+	objectType := d.Get("object_type").(string)
+
+	switch objectType {
+	case "schema":
+		if !db.featureSupported(featurePrivilegesOnSchemas) {
+			return fmt.Errorf(
+				"changing default privileges for schemas is not supported for this Postgres version (%s)",
+				db.version,
+			)
+		}
+	case "large_object":
+		if !db.featureSupported(featureDefaultPrivilegesLargeObjects) {
+			return fmt.Errorf(
+				"changing default privileges for large objects is not supported for this Postgres version (%s)",
+				db.version,
+			)
+		}
+	case "routine":
+		if !db.featureSupported(featureRoutine) {
+			return fmt.Errorf(
+				"object type ROUTINE is not supported for this Postgres version (%s)",
+				db.version,
+			)
+		}
+	}
+
+	if pgSchema != "" && (objectType == "schema" || objectType == "large_object") {
+		return fmt.Errorf("cannot specify `schema` when `object_type` is `%s`", objectType)
+	}
+
+	return nil
+}
+
+func validateDefaultPrivileges(db *DBConnection, d *schema.ResourceData) error {
+	if err := validateDefaultPrivilegesFeatures(db, d); err != nil {
+		return err
+	}
+
+	if d.Get("with_grant_option").(bool) && strings.ToLower(d.Get("role").(string)) == "public" {
+		return fmt.Errorf("with_grant_option cannot be true for role 'public'")
+	}
+
+	return validatePrivileges(d)
+}
+
+// defaultPrivilegesOwners returns the sorted list of roles for which the default privileges are managed,
+// either from `owner` or `owners`.
+func defaultPrivilegesOwners(d *schema.ResourceData) []string {
+	if owner := d.Get("owner").(string); owner != "" {
+		return []string{owner}
+	}
+
+	var owners []string
+	for _, owner := range d.Get("owners").(*schema.Set).List() {
+		owners = append(owners, owner.(string))
+	}
+	sort.Strings(owners)
+	return owners
+}
+
+// lockDefaultPrivilegesOwners locks the owners in a deterministic order to avoid deadlocks
+// between resources sharing some owners.
+func lockDefaultPrivilegesOwners(txn *sql.Tx, owners []string) error {
+	for _, owner := range owners {
+		if err := pgLockRole(txn, owner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func readRoleDefaultPrivileges(txn *sql.Tx, d *schema.ResourceData) error {
 	role := d.Get("role").(string)
-	owner := d.Get("owner").(string)
 	pgSchema := d.Get("schema").(string)
-	objectType := d.Get("object_type").(string)
 	privilegesInput := d.Get("privileges").(*schema.Set).List()
 
-	if err := pgLockRole(txn, owner); err != nil {
+	owners := defaultPrivilegesOwners(d)
+	if err := lockDefaultPrivilegesOwners(txn, owners); err != nil {
 		return err
 	}
 
@@ -240,96 +439,191 @@ func readRoleDefaultPrivileges(txn *sql.Tx, d *schema.ResourceData) error {
 		return err
 	}
 
+	// The privileges are the same for every owner if the resource is in sync,
+	// otherwise we keep the first privileges which differ from the wanted ones.
+	var privilegesSet *schema.Set
+	hasPrivileges, differs := false, false
+	for _, owner := range owners {
+		privileges, err := readOwnerDefaultPrivileges(txn, d, roleOID, owner)
+		if err != nil {
+			return err
+		}
+		if len(privileges) != 0 {
+			hasPrivileges = true
+		}
+
+		ownerSet := stringSliceToSet(privileges)
+		if privilegesSet == nil || (!differs && !resourcePrivilegesEqual(ownerSet, d)) {
+			privilegesSet = ownerSet
+			differs = !resourcePrivilegesEqual(ownerSet, d)
+		}
+	}
+
+	// We consider no privileges as "not exists" unless no privileges were provided as input
+	if !hasPrivileges {
+		log.Printf("[DEBUG] no default privileges for role %s in schema %s", role, pgSchema)
+		if len(privilegesInput) != 0 {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	if !resourcePrivilegesEqual(privilegesSet, d) {
+		d.Set("privileges", privilegesSet)
+	}
+	d.SetId(generateDefaultPrivilegesID(d))
+
+	return nil
+}
+
+// readOwnerDefaultPrivileges returns the default privileges granted to the role (by its OID)
+// on the objects created by owner.
+func readOwnerDefaultPrivileges(txn *sql.Tx, d *schema.ResourceData, roleOID uint32, owner string) ([]string, error) {
+	pgSchema := d.Get("schema").(string)
+	objectType := d.Get("object_type").(string)
+
 	var query string
 	var queryArgs []any
 
+	// These queries aggregate the list of default privileges type (prtype)
+	// for the role (grantee), owner (defaclrole), schema (namespace name)
+	// and the specified object type (defaclobjtype).
 	if pgSchema != "" {
 		query = `SELECT array_agg(prtype) FROM (
-		SELECT defaclnamespace, (aclexplode(defaclacl)).* FROM pg_default_acl
-		WHERE defaclobjtype = $3
-	) AS t (namespace, grantor_oid, grantee_oid, prtype, grantable)
-	JOIN pg_namespace ON pg_namespace.oid = namespace
-	WHERE grantee_oid = $1 AND nspname = $2 AND pg_get_userbyid(grantor_oid) = $4;
+		SELECT (aclexplode(defaclacl)).* FROM pg_default_acl
+		JOIN pg_namespace ON pg_namespace.oid = defaclnamespace
+		WHERE defaclrole = (SELECT oid FROM pg_roles WHERE rolname = $4)
+		AND nspname = $2 AND defaclobjtype = $3
+	) AS t
+	WHERE grantee = $1
 `
 		queryArgs = []any{roleOID, pgSchema, objectTypes[objectType], owner}
 	} else {
+		// Global default privileges (defaclnamespace = 0) replace the built-in defaults of the
+		// object type (e.g.: EXECUTE to PUBLIC for functions) so we fall back on them
+		// if the owner has no entry.
 		query = `SELECT array_agg(prtype) FROM (
-		SELECT defaclnamespace, (aclexplode(defaclacl)).* FROM pg_default_acl
-		WHERE defaclobjtype = $2
-	) AS t (namespace, grantor_oid, grantee_oid, prtype, grantable)
-	WHERE grantee_oid = $1 AND namespace = 0 AND pg_get_userbyid(grantor_oid) = $3;
+		SELECT (aclexplode(COALESCE(
+			(
+				SELECT defaclacl FROM pg_default_acl
+				WHERE defaclrole = r.oid AND defaclnamespace = 0 AND defaclobjtype = $2
+			),
+			acldefault($3, r.oid)
+		))).*
+		FROM pg_roles r
+		WHERE r.rolname = $4
+	) AS t
+	WHERE grantee = $1
 `
-		queryArgs = []any{roleOID, objectTypes[objectType], owner}
+		queryArgs = []any{roleOID, objectTypes[objectType], aclDefaultObjectTypes[objectType], owner}
 	}
 
-	// This query aggregates the list of default privileges type (prtype)
-	// for the role (grantee), owner (grantor), schema (namespace name)
-	// and the specified object type (defaclobjtype).
-
 	var privileges pq.ByteaArray
 	if err := txn.QueryRow(
 		query, queryArgs...,
 	).Scan(&privileges); err != nil {
-		return fmt.Errorf("could not read default privileges: %w", err)
+		return nil, fmt.Errorf("could not read default privileges: %w", err)
 	}
 
-	// We consider no privileges as "not exists" unless no privileges were provided as input
-	if len(privileges) == 0 {
-		log.Printf("[DEBUG] no default privileges for role %s in schema %s", role, pgSchema)
-		if len(privilegesInput) != 0 {
-			d.SetId("")
-			return nil
+	result := make([]string, len(privileges))
+	for i, privilege := range privileges {
+		result[i] = string(privilege)
+	}
+	return result, nil
+}
+
+// updateOwnerDefaultPrivileges revokes the privileges of current which are not wanted anymore
+// and grants the missing ones for a single owner.
+func updateOwnerDefaultPrivileges(txn *sql.Tx, d *schema.ResourceData, owner string, current []string) error {
+	wanted := d.Get("privileges").(*schema.Set)
+
+	// ALL cannot be compared to the privileges read from the database, so we fall back on revoke + grant
+	if wanted.Contains("ALL") {
+		for _, action := range []string{"REVOKE", "GRANT"} {
+			privileges := []string{"ALL"}
+			if _, err := txn.Exec(defaultPrivilegesQuery(d, []string{owner}, action, privileges)); err != nil {
+				return fmt.Errorf("could not alter default privileges: %w", err)
+			}
 		}
+		return nil
 	}
 
-	privilegesSet := pgArrayToSet(privileges)
-	privilegesEqual := resourcePrivilegesEqual(privilegesSet, d)
+	currentSet := stringSliceToSet(current)
 
-	if !privilegesEqual {
-		d.Set("privileges", privilegesSet)
+	var toRevoke, toGrant []string
+	for _, privilege := range currentSet.Difference(wanted).List() {
+		toRevoke = append(toRevoke, privilege.(string))
+	}
+	for _, privilege := range wanted.Difference(currentSet).List() {
+		toGrant = append(toGrant, privilege.(string))
+	}
+	sort.Strings(toRevoke)
+	sort.Strings(toGrant)
+
+	if len(toRevoke) != 0 {
+		if _, err := txn.Exec(defaultPrivilegesQuery(d, []string{owner}, "REVOKE", toRevoke)); err != nil {
+			return fmt.Errorf("could not revoke default privileges: %w", err)
+		}
+	}
+	if len(toGrant) != 0 {
+		if _, err := txn.Exec(defaultPrivilegesQuery(d, []string{owner}, "GRANT", toGrant)); err != nil {
+			return fmt.Errorf("could not alter default privileges: %w", err)
+		}
 	}
-	d.SetId(generateDefaultPrivilegesID(d))
 
 	return nil
 }
 
-func grantRoleDefaultPrivileges(txn *sql.Tx, d *schema.ResourceData) error {
-	role := d.Get("role").(string)
-	pgSchema := d.Get("schema").(string)
-
-	privileges := []string{}
-	for _, priv := range d.Get("privileges").(*schema.Set).List() {
-		privileges = append(privileges, priv.(string))
+// defaultPrivilegesQuery builds the ALTER DEFAULT PRIVILEGES query to grant or revoke (action)
+// the privileges to the role on the objects created by the owners.
+func defaultPrivilegesQuery(d *schema.ResourceData, owners []string, action string, privileges []string) string {
+	quotedOwners := make([]string, len(owners))
+	for i, owner := range owners {
+		quotedOwners[i] = pq.QuoteIdentifier(owner)
 	}
 
-	if len(privileges) == 0 {
-		log.Printf("[DEBUG] no default privileges to grant for role %s, owner %s in database: %s,", d.Get("role").(string), d.Get("owner").(string), d.Get("database").(string))
-		return nil
-	}
-
-	var inSchema string
+	query := fmt.Sprintf("ALTER DEFAULT PRIVILEGES FOR ROLE %s", strings.Join(quotedOwners, ","))
 
 	// If a schema is specified we need to build the part of the query string to action this
-	if pgSchema != "" {
-		inSchema = fmt.Sprintf("IN SCHEMA %s", pq.QuoteIdentifier(pgSchema))
+	if pgSchema := d.Get("schema").(string); pgSchema != "" {
+		query += fmt.Sprintf(" IN SCHEMA %s", pq.QuoteIdentifier(pgSchema))
+	}
+
+	target := "TO"
+	if action == "REVOKE" {
+		target = "FROM"
 	}
 
-	query := fmt.Sprintf("ALTER DEFAULT PRIVILEGES FOR ROLE %s %s GRANT %s ON %sS TO %s",
-		pq.QuoteIdentifier(d.Get("owner").(string)),
-		inSchema,
+	query += fmt.Sprintf(" %s %s ON %s %s %s",
+		action,
 		strings.Join(privileges, ","),
-		strings.ToUpper(d.Get("object_type").(string)),
-		pq.QuoteIdentifier(role),
+		defaultPrivilegesObjects[d.Get("object_type").(string)],
+		target,
+		pq.QuoteIdentifier(d.Get("role").(string)),
 	)
 
-	if d.Get("with_grant_option").(bool) {
-		query = query + " WITH GRANT OPTION"
+	if action == "GRANT" && d.Get("with_grant_option").(bool) {
+		query += " WITH GRANT OPTION"
 	}
 
-	_, err := txn.Exec(
-		query,
-	)
-	if err != nil {
+	return query
+}
+
+func grantRoleDefaultPrivileges(txn *sql.Tx, d *schema.ResourceData) error {
+	privileges := []string{}
+	for _, priv := range d.Get("privileges").(*schema.Set).List() {
+		privileges = append(privileges, priv.(string))
+	}
+	sort.Strings(privileges)
+
+	if len(privileges) == 0 {
+		log.Printf("[DEBUG] no default privileges to grant for role %s, owners %v in database: %s,", d.Get("role").(string), defaultPrivilegesOwners(d), d.Get("database").(string))
+		return nil
+	}
+
+	if _, err := txn.Exec(
+		defaultPrivilegesQuery(d, defaultPrivilegesOwners(d), "GRANT", privileges),
+	); err != nil {
 		return fmt.Errorf("could not alter default privileges: %w", err)
 	}
 
@@ -337,23 +631,9 @@ func grantRoleDefaultPrivileges(txn *sql.Tx, d *schema.ResourceData) error {
 }
 
 func revokeRoleDefaultPrivileges(txn *sql.Tx, d *schema.ResourceData) error {
-	pgSchema := d.Get("schema").(string)
-
-	var inSchema string
-
-	// If a schema is specified we need to build the part of the query string to action this
-	if pgSchema != "" {
-		inSchema = fmt.Sprintf("IN SCHEMA %s", pq.QuoteIdentifier(pgSchema))
-	}
-	query := fmt.Sprintf(
-		"ALTER DEFAULT PRIVILEGES FOR ROLE %s %s REVOKE ALL ON %sS FROM %s",
-		pq.QuoteIdentifier(d.Get("owner").(string)),
-		inSchema,
-		strings.ToUpper(d.Get("object_type").(string)),
-		pq.QuoteIdentifier(d.Get("role").(string)),
-	)
-
-	if _, err := txn.Exec(query); err != nil {
+	if _, err := txn.Exec(
+		defaultPrivilegesQuery(d, defaultPrivilegesOwners(d), "REVOKE", []string{"ALL"}),
+	); err != nil {
 		return fmt.Errorf("could not revoke default privileges: %w", err)
 	}
 	return nil
@@ -367,7 +647,7 @@ func generateDefaultPrivilegesID(d *schema.ResourceData) string {
 
 	return strings.Join([]string{
 		d.Get("role").(string), d.Get("database").(string), pgSchema,
-		d.Get("owner").(string), d.Get("object_type").(string),
+		strings.Join(defaultPrivilegesOwners(d), ","), d.Get("object_type").(string),
 	}, "_")
 
 }
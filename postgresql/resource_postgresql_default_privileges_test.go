@@ -2,10 +2,13 @@ package postgresql
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccPostgresqlDefaultPrivileges(t *testing.T) {
@@ -262,6 +265,11 @@ resource "postgresql_default_privileges" "test_ro" {
 							resource.TestCheckResourceAttr("postgresql_default_privileges.test_ro", "privileges.1", "UPDATE"),
 						),
 					},
+					{
+						ResourceName:      "postgresql_default_privileges.test_ro",
+						ImportState:       true,
+						ImportStateVerify: true,
+					},
 				},
 			})
 		})
@@ -398,3 +406,237 @@ resource "postgresql_default_privileges" "test" {
 		},
 	})
 }
+
+// Test the same default privileges managed for several owners, updated in place.
+func TestAccPostgresqlDefaultPrivileges_Owners(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	config := getTestConfig(t)
+	dbName, roleName := getTestDBNames(dbSuffix)
+
+	hclText := `
+resource postgresql_role "test_owner" {
+    name = "test_owner"
+}
+
+// From PostgreSQL 15, schema public is not wild open anymore
+resource "postgresql_grant" "public_usage" {
+	database          = "%s"
+	schema            = "public"
+	role              = postgresql_role.test_owner.name
+	object_type       = "schema"
+	privileges        = ["CREATE", "USAGE"]
+}
+
+resource "postgresql_default_privileges" "test_ro" {
+	database    = "%s"
+	owners      = ["%s", postgresql_role.test_owner.name]
+	role        = "%s"
+	schema      = "public"
+	object_type = "table"
+	privileges  = %%s
+}
+`
+	tfConfig := fmt.Sprintf(hclText, dbName, dbName, config.Username, roleName)
+
+	owners := []string{config.Username, "test_owner"}
+	sort.Strings(owners)
+
+	checkOwnersTables := func(privileges []string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			dropFunc := createTestTables(t, dbSuffix, []string{"public.test_table"}, "")
+			defer dropFunc()
+			dropOwnerFunc := createTestTables(t, dbSuffix, []string{"public.test_owner_table"}, "test_owner")
+			defer dropOwnerFunc()
+
+			return testCheckTablesPrivileges(
+				t, dbName, roleName, []string{"public.test_table", "public.test_owner_table"}, privileges,
+			)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featurePrivileges)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(tfConfig, `["SELECT", "INSERT"]`),
+				Check: resource.ComposeTestCheckFunc(
+					checkOwnersTables([]string{"SELECT", "INSERT"}),
+					resource.TestCheckResourceAttr(
+						"postgresql_default_privileges.test_ro", "id",
+						fmt.Sprintf("%s_%s_public_%s_table", roleName, dbName, strings.Join(owners, ",")),
+					),
+					resource.TestCheckResourceAttr("postgresql_default_privileges.test_ro", "owners.#", "2"),
+					resource.TestCheckResourceAttr("postgresql_default_privileges.test_ro", "privileges.#", "2"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(tfConfig, `["SELECT", "UPDATE"]`),
+				Check: resource.ComposeTestCheckFunc(
+					checkOwnersTables([]string{"SELECT", "UPDATE"}),
+					resource.TestCheckResourceAttr("postgresql_default_privileges.test_ro", "privileges.#", "2"),
+					resource.TestCheckResourceAttr("postgresql_default_privileges.test_ro", "privileges.0", "SELECT"),
+					resource.TestCheckResourceAttr("postgresql_default_privileges.test_ro", "privileges.1", "UPDATE"),
+				),
+			},
+			{
+				ResourceName:      "postgresql_default_privileges.test_ro",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// Test that global default privileges are read from the built-in defaults when
+// the owner has no pg_default_acl entry (functions are executable by PUBLIC by default).
+func TestAccPostgresqlDefaultPrivileges_GlobalBuiltinDefaults(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	config := getTestConfig(t)
+	dbName, _ := getTestDBNames(dbSuffix)
+
+	hclText := `
+resource "postgresql_default_privileges" "public_functions" {
+	database    = "%s"
+	owner       = "%s"
+	role        = "public"
+	object_type = "function"
+	privileges  = %%s
+}
+`
+	tfConfig := fmt.Sprintf(hclText, dbName, config.Username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featurePrivileges)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(tfConfig, `["EXECUTE"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_default_privileges.public_functions", "privileges.#", "1"),
+					resource.TestCheckResourceAttr("postgresql_default_privileges.public_functions", "privileges.0", "EXECUTE"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(tfConfig, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_default_privileges.public_functions", "privileges.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlDefaultPrivileges_LargeObjects(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	config := getTestConfig(t)
+	dbName, roleName := getTestDBNames(dbSuffix)
+
+	tfConfig := fmt.Sprintf(`
+resource "postgresql_default_privileges" "test_lo" {
+	database    = "%s"
+	owner       = "%s"
+	role        = "%s"
+	object_type = "large_object"
+	privileges  = ["SELECT"]
+}
+`, dbName, config.Username, roleName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureDefaultPrivilegesLargeObjects)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: tfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"postgresql_default_privileges.test_lo", "id",
+						fmt.Sprintf("%s_%s_noschema_%s_large_object", roleName, dbName, config.Username),
+					),
+					resource.TestCheckResourceAttr("postgresql_default_privileges.test_lo", "privileges.#", "1"),
+					resource.TestCheckResourceAttr("postgresql_default_privileges.test_lo", "privileges.0", "SELECT"),
+				),
+			},
+			{
+				ResourceName:      "postgresql_default_privileges.test_lo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestDefaultPrivilegesQuery(t *testing.T) {
+	cases := []struct {
+		name       string
+		values     map[string]any
+		owners     []string
+		action     string
+		privileges []string
+		expected   string
+	}{
+		{
+			name: "grant in schema",
+			values: map[string]any{
+				"role": "reader", "schema": "app", "object_type": "table", "with_grant_option": true,
+			},
+			owners:     []string{"owner_a", "owner_b"},
+			action:     "GRANT",
+			privileges: []string{"INSERT", "SELECT"},
+			expected:   `ALTER DEFAULT PRIVILEGES FOR ROLE "owner_a","owner_b" IN SCHEMA "app" GRANT INSERT,SELECT ON TABLES TO "reader" WITH GRANT OPTION`,
+		},
+		{
+			name: "revoke global",
+			values: map[string]any{
+				"role": "public", "object_type": "function",
+			},
+			owners:     []string{"owner"},
+			action:     "REVOKE",
+			privileges: []string{"ALL"},
+			expected:   `ALTER DEFAULT PRIVILEGES FOR ROLE "owner" REVOKE ALL ON FUNCTIONS FROM "public"`,
+		},
+		{
+			name: "large objects",
+			values: map[string]any{
+				"role": "reader", "object_type": "large_object", "with_grant_option": true,
+			},
+			owners:     []string{"owner"},
+			action:     "REVOKE",
+			privileges: []string{"UPDATE"},
+			expected:   `ALTER DEFAULT PRIVILEGES FOR ROLE "owner" REVOKE UPDATE ON LARGE OBJECTS FROM "reader"`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := resourcePostgreSQLDefaultPrivileges().Data(nil)
+			for key, value := range c.values {
+				if err := d.Set(key, value); err != nil {
+					t.Fatal(err)
+				}
+			}
+			assert.Equal(t, c.expected, defaultPrivilegesQuery(d, c.owners, c.action, c.privileges))
+		})
+	}
+}
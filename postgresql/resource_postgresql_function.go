@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,6 +27,12 @@ const (
 	funcSecurityDefinerAttr = "security_definer"
 	funcStrictAttr          = "strict"
 	funcVolatilityAttr      = "volatility"
+	funcLeakproofAttr       = "leakproof"
+	funcCostAttr            = "cost"
+	funcRowsAttr            = "rows"
+	funcSupportAttr         = "support"
+	funcSetAttr             = "set"
+	funcReturnsTableAttr    = "returns_table"
 
 	funcArgTypeAttr    = "type"
 	funcArgNameAttr    = "name"
@@ -113,14 +121,39 @@ func resourcePostgreSQLFunction() *schema.Resource {
 				DiffSuppressFunc: defaultDiffSuppressFunc,
 			},
 			funcReturnsAttr: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Computed:    true,
-				Description: "Function return type. If not specified, it will be calculated based on the output arguments",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: []string{funcReturnsTableAttr},
+				Description:   "Function return type. If not specified, it will be calculated based on the output arguments",
 
 				DiffSuppressFunc: defaultDiffSuppressFunc,
 			},
+			funcReturnsTableAttr: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{funcReturnsAttr},
+				Description:   "Columns of the table returned by the function (RETURNS TABLE)",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						funcArgNameAttr: {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The column name.",
+						},
+						funcArgTypeAttr: {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The column type.",
+						},
+					},
+				},
+			},
 			funcBodyAttr: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -167,6 +200,37 @@ func resourcePostgreSQLFunction() *schema.Resource {
 				DiffSuppressFunc: defaultDiffSuppressFunc,
 				ValidateFunc:     validation.StringInSlice([]string{"VOLATILE", "STABLE", "IMMUTABLE"}, false),
 			},
+			funcLeakproofAttr: {
+				Type:        schema.TypeBool,
+				Description: "If the function has no side effects and reveals no information about its arguments other than by its return value.",
+				Optional:    true,
+				Default:     false,
+			},
+			funcCostAttr: {
+				Type:         schema.TypeFloat,
+				Description:  "Estimated execution cost of the function, in units of cpu_operator_cost.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			funcRowsAttr: {
+				Type:         schema.TypeFloat,
+				Description:  "Estimated number of rows returned by a set-returning function.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			funcSupportAttr: {
+				Type:        schema.TypeString,
+				Description: "Planner support function of the function.",
+				Optional:    true,
+			},
+			funcSetAttr: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Configuration parameters set when the function is called (SET clauses), e.g. search_path",
+			},
 			funcDatabaseAttr: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -259,9 +323,18 @@ func resourcePostgreSQLFunctionReadImpl(db *DBConnection, d *schema.ResourceData
 		return expandErr
 	}
 
-	var funcDefinition string
+	var funcDefinition, funcSupport string
+	var funcLeakproof bool
+	var funcCost, funcRows float64
+	var funcConfig pq.ByteaArray
+
+	supportColumn := "''"
+	if db.featureSupported(featureFunctionSupport) {
+		supportColumn = "CASE WHEN p.prosupport = 0 THEN '' ELSE p.prosupport::regproc::text END"
+	}
 
-	query := `SELECT pg_get_functiondef(p.oid::regproc) funcDefinition ` +
+	query := `SELECT pg_get_functiondef(p.oid::regproc) funcDefinition, ` +
+		`p.proleakproof, p.procost, p.prorows, p.proconfig, ` + supportColumn + ` ` +
 		`FROM pg_proc p ` +
 		`LEFT JOIN pg_namespace n ON p.pronamespace = n.oid ` +
 		`WHERE p.oid = to_regprocedure($1)`
@@ -272,7 +345,9 @@ func resourcePostgreSQLFunctionReadImpl(db *DBConnection, d *schema.ResourceData
 	}
 	defer deferredRollback(txn)
 
-	err = txn.QueryRow(query, functionSignature).Scan(&funcDefinition)
+	err = txn.QueryRow(query, functionSignature).Scan(
+		&funcDefinition, &funcLeakproof, &funcCost, &funcRows, &funcConfig, &funcSupport,
+	)
 	switch {
 	case err == sql.ErrNoRows:
 		log.Printf("[WARN] PostgreSQL function: %s", functionId)
@@ -282,6 +357,12 @@ func resourcePostgreSQLFunctionReadImpl(db *DBConnection, d *schema.ResourceData
 		return fmt.Errorf("error reading function: %w", err)
 	}
 
+	// regproc omits the schema of the support function if it is in the search_path,
+	// the configured name is kept if it refers to the same function.
+	if funcSupport, err = resolveCatalogName(txn, "regproc", d.Get(funcSupportAttr).(string), funcSupport); err != nil {
+		return err
+	}
+
 	if err := txn.Commit(); err != nil {
 		return err
 	}
@@ -316,6 +397,23 @@ func resourcePostgreSQLFunctionReadImpl(db *DBConnection, d *schema.ResourceData
 	d.Set(funcVolatilityAttr, pgFunction.Volatility)
 	d.Set(funcArgAttr, args)
 
+	var returnsTable []map[string]any
+	for _, c := range pgFunction.ReturnsTable {
+		returnsTable = append(returnsTable, map[string]any{
+			funcArgNameAttr: c.Name,
+			funcArgTypeAttr: c.Type,
+		})
+	}
+	d.Set(funcReturnsTableAttr, returnsTable)
+
+	// These attributes are read from pg_proc rather than from the parsed definition
+	// as pg_get_functiondef omits their default values.
+	d.Set(funcLeakproofAttr, funcLeakproof)
+	d.Set(funcCostAttr, funcCost)
+	d.Set(funcRowsAttr, funcRows)
+	d.Set(funcSupportAttr, funcSupport)
+	d.Set(funcSetAttr, readParameters(funcConfig))
+
 	d.SetId(functionId)
 
 	return nil
@@ -437,6 +535,27 @@ func createFunction(db *DBConnection, d *schema.ResourceData, replace bool) erro
 	if pgFunction.Strict {
 		fmt.Fprint(b, "\nSTRICT")
 	}
+	if pgFunction.Leakproof {
+		fmt.Fprint(b, "\nLEAKPROOF")
+	}
+	if pgFunction.Cost > 0 {
+		fmt.Fprint(b, "\nCOST ", strconv.FormatFloat(pgFunction.Cost, 'f', -1, 64))
+	}
+	if pgFunction.Rows > 0 {
+		fmt.Fprint(b, "\nROWS ", strconv.FormatFloat(pgFunction.Rows, 'f', -1, 64))
+	}
+	if pgFunction.Support != "" {
+		fmt.Fprint(b, "\nSUPPORT ", pgFunction.Support)
+	}
+
+	parameterNames := make([]string, 0, len(pgFunction.Parameters))
+	for name := range pgFunction.Parameters {
+		parameterNames = append(parameterNames, name)
+	}
+	sort.Strings(parameterNames)
+	for _, name := range parameterNames {
+		fmt.Fprint(b, "\nSET ", quoteParameterName(name), " TO ", formatParameterValue(name, pgFunction.Parameters[name]))
+	}
 
 	fmt.Fprint(b, "\nAS $function$", pgFunction.Body, "$function$;")
 
@@ -222,6 +222,146 @@ resource "postgresql_function" "func" {
 	})
 }
 
+func TestAccPostgresqlFunction_Options(t *testing.T) {
+	configCreate := `
+resource "postgresql_function" "func_options" {
+    name = "func_options"
+    arg {
+        name = "num"
+        type = "integer"
+    }
+    returns_table {
+        name = "id"
+        type = "integer"
+    }
+    returns_table {
+        name = "label"
+        type = "text"
+    }
+    language = "plpgsql"
+    security_definer = true
+    cost = 42
+    rows = 10
+    set = {
+        search_path = "public, pg_temp"
+    }
+    body = <<-EOF
+        BEGIN
+            RETURN QUERY SELECT num, 'label'::text;
+        END;
+    EOF
+}
+`
+
+	configUpdate := `
+resource "postgresql_function" "func_options" {
+    name = "func_options"
+    arg {
+        name = "num"
+        type = "integer"
+    }
+    returns_table {
+        name = "id"
+        type = "integer"
+    }
+    returns_table {
+        name = "label"
+        type = "text"
+    }
+    language = "plpgsql"
+    security_definer = true
+    cost = 10
+    rows = 5
+    set = {
+        search_path = "pg_catalog, pg_temp"
+        work_mem    = "64MB"
+    }
+    body = <<-EOF
+        BEGIN
+            RETURN QUERY SELECT num, 'label'::text;
+        END;
+    EOF
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureFunction)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: configCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"postgresql_function.func_options", "returns", "TABLE(id integer, label text)"),
+					resource.TestCheckResourceAttr(
+						"postgresql_function.func_options", "returns_table.#", "2"),
+					resource.TestCheckResourceAttr(
+						"postgresql_function.func_options", "cost", "42"),
+					resource.TestCheckResourceAttr(
+						"postgresql_function.func_options", "rows", "10"),
+					resource.TestCheckResourceAttr(
+						"postgresql_function.func_options", "leakproof", "false"),
+					resource.TestCheckResourceAttr(
+						"postgresql_function.func_options", "set.search_path", "public, pg_temp"),
+				),
+			},
+			{
+				Config: configUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"postgresql_function.func_options", "cost", "10"),
+					resource.TestCheckResourceAttr(
+						"postgresql_function.func_options", "rows", "5"),
+					resource.TestCheckResourceAttr(
+						"postgresql_function.func_options", "set.search_path", "pg_catalog, pg_temp"),
+					resource.TestCheckResourceAttr(
+						"postgresql_function.func_options", "set.work_mem", "64MB"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlFunction_Support(t *testing.T) {
+	config := `
+resource "postgresql_function" "func_support" {
+    name = "func_support"
+    arg {
+        type = "text"
+    }
+    arg {
+        type = "text"
+    }
+    returns  = "boolean"
+    language = "sql"
+    support  = "pg_catalog.textlike_support"
+    body     = "SELECT $1 LIKE $2"
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureFunctionSupport)
+			testSuperuserPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					// The schema of the support function is kept as configured
+					resource.TestCheckResourceAttr(
+						"postgresql_function.func_support", "support", "pg_catalog.textlike_support"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPostgresqlFunctionExists(n string, database string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
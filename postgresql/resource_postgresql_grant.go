@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,15 +25,22 @@ var allowedObjectTypes = []string{
 	"foreign_data_wrapper",
 	"foreign_server",
 	"column",
+	"tablespace",
+	"type",
+	"domain",
+	"language",
+	"large_object",
+	"parameter",
 }
 
 var objectTypes = map[string]string{
-	"table":    "r",
-	"sequence": "S",
-	"function": "f",
-	"routine":  "f",
-	"type":     "T",
-	"schema":   "n",
+	"table":        "r",
+	"sequence":     "S",
+	"function":     "f",
+	"routine":      "f",
+	"type":         "T",
+	"schema":       "n",
+	"large_object": "L",
 }
 
 type ResourceSchemeGetter func(string) any
@@ -43,6 +51,9 @@ func resourcePostgreSQLGrant() *schema.Resource {
 		Update: PGResourceFunc(resourcePostgreSQLGrantUpdate),
 		Read:   PGResourceFunc(resourcePostgreSQLGrantRead),
 		Delete: PGResourceFunc(resourcePostgreSQLGrantDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: PGResourceImportFunc(resourcePostgreSQLGrantImport),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role": {
@@ -100,10 +111,249 @@ func resourcePostgreSQLGrant() *schema.Resource {
 				Default:     false,
 				Description: "Permit the grant recipient to grant it to others",
 			},
+			"objects_missing_privileges": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The objects which lack some of the granted privileges (e.g.: tables created after granting on all the tables of the schema)",
+			},
 		},
 	}
 }
 
+// grantObjectTypesWithoutSchema are the object types for which the grant ID doesn't contain the schema.
+var grantObjectTypesWithoutSchema = []string{
+	"database", "foreign_data_wrapper", "foreign_server", "tablespace", "language", "large_object", "parameter",
+}
+
+// grantObjectTypesWithObjects are the object types which have no ALL ... IN SCHEMA form,
+// so the objects to grant privileges on must be specified.
+var grantObjectTypesWithObjects = []string{"type", "domain", "language", "large_object", "parameter"}
+
+// resourcePostgreSQLGrantImport parses the ID generated by generateGrantID, i.e.:
+// role_database_objecttype[_objects] or role_database_schema_objecttype[_objects][_columns]
+// and reads the privileges currently granted.
+func resourcePostgreSQLGrantImport(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featurePrivileges) {
+		return fmt.Errorf(
+			"postgresql_grant resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	var objectTypesWithSchema []string
+	for _, objectType := range allowedObjectTypes {
+		if !sliceContainsStr(grantObjectTypesWithoutSchema, objectType) {
+			objectTypesWithSchema = append(objectTypesWithSchema, objectType)
+		}
+	}
+
+	// Try first without schema (e.g.: role_database_objecttype_objects)
+	parsed, err := splitImportID(d.Id(), []importIDMatcher{
+		importRoleMatcher(db, true),
+		importDatabaseMatcher(db),
+		importObjectTypeMatcher(grantObjectTypesWithoutSchema),
+	}, importGrantObjectMatcher(db, 2))
+	if err != nil {
+		return err
+	}
+	if parsed != nil {
+		parsed = append(parsed[:2], append([]string{""}, parsed[2:]...)...)
+	} else {
+		parsed, err = splitImportID(d.Id(), []importIDMatcher{
+			importRoleMatcher(db, true),
+			importDatabaseMatcher(db),
+			importSchemaMatcher(db, 1),
+			importObjectTypeMatcher(objectTypesWithSchema),
+		}, importGrantObjectMatcher(db, 3))
+		if err != nil {
+			return err
+		}
+	}
+	if parsed == nil {
+		return fmt.Errorf(
+			"grant ID %s has not the expected format 'role_database[_schema]_objecttype[_objects]' or does not match existing role, database, schema and objects",
+			d.Id(),
+		)
+	}
+
+	objectType := parsed[3]
+	objects := []any{}
+	columns := []any{}
+	for i, name := range parsed[4:] {
+		if objectType == "column" && i > 0 {
+			columns = append(columns, name)
+		} else {
+			objects = append(objects, name)
+		}
+	}
+
+	d.Set("role", parsed[0])
+	d.Set("database", parsed[1])
+	d.Set("schema", parsed[2])
+	d.Set("object_type", objectType)
+	d.Set("objects", schema.NewSet(schema.HashString, objects))
+	d.Set("columns", schema.NewSet(schema.HashString, columns))
+	d.Set("with_grant_option", false)
+
+	if objectType == "column" && (len(objects) != 1 || len(columns) == 0) {
+		return fmt.Errorf("grant ID %s has not the expected format 'role_database_schema_column_table_columns'", d.Id())
+	}
+
+	txn, err := startTransaction(db.client, parsed[1])
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if objectType == "column" {
+		// readColumnRolePrivileges checks the privilege saved in the state, so we have to read it first.
+		privilege, err := readColumnImportPrivilege(txn, d)
+		if err != nil {
+			return err
+		}
+		d.Set("privileges", schema.NewSet(schema.HashString, []any{privilege}))
+	}
+
+	if err := readRolePrivileges(txn, d); err != nil {
+		return err
+	}
+
+	d.SetId(generateGrantID(d))
+
+	return nil
+}
+
+// importObjectTypeMatcher matches the object types in the allowed list.
+func importObjectTypeMatcher(allowed []string) importIDMatcher {
+	return func(_ []string, name string) (bool, error) {
+		return sliceContainsStr(allowed, name), nil
+	}
+}
+
+// importGrantObjectMatcher matches the objects of a grant ID, the object type being parsed at objectTypeIndex
+// (the database always being parsed at index 1 and the schema at index 2 when applicable).
+// For object type column, the first object is the table and the next ones its columns.
+func importGrantObjectMatcher(db *DBConnection, objectTypeIndex int) importIDMatcher {
+	return func(matched []string, name string) (bool, error) {
+		database := matched[1]
+		objectType := matched[objectTypeIndex]
+
+		switch objectType {
+		case "table", "sequence":
+			return objectExistsInDB(db, database,
+				"SELECT 1 FROM pg_catalog.pg_class c JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace "+
+					"WHERE n.nspname = $1 AND c.relname = $2",
+				matched[2], name,
+			)
+		case "function", "procedure", "routine":
+			// The object can contain the arguments of the function, e.g.: my_func(a_b integer)
+			funcName, _, hasArgs := strings.Cut(name, "(")
+			if hasArgs && !strings.HasSuffix(name, ")") {
+				return false, nil
+			}
+			return objectExistsInDB(db, database,
+				"SELECT 1 FROM pg_catalog.pg_proc p JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace "+
+					"WHERE n.nspname = $1 AND p.proname = $2 LIMIT 1",
+				matched[2], funcName,
+			)
+		case "column":
+			if len(matched) == objectTypeIndex+1 {
+				return objectExistsInDB(db, database,
+					"SELECT 1 FROM pg_catalog.pg_class c JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace "+
+						"WHERE n.nspname = $1 AND c.relname = $2",
+					matched[2], name,
+				)
+			}
+			return objectExistsInDB(db, database,
+				"SELECT 1 FROM pg_catalog.pg_attribute a JOIN pg_catalog.pg_class c ON c.oid = a.attrelid "+
+					"JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace "+
+					"WHERE n.nspname = $1 AND c.relname = $2 AND a.attname = $3 AND a.attnum > 0 AND NOT a.attisdropped",
+				matched[2], matched[objectTypeIndex+1], name,
+			)
+		case "foreign_data_wrapper":
+			return objectExistsInDB(db, database, "SELECT 1 FROM pg_catalog.pg_foreign_data_wrapper WHERE fdwname = $1", name)
+		case "foreign_server":
+			return objectExistsInDB(db, database, "SELECT 1 FROM pg_catalog.pg_foreign_server WHERE srvname = $1", name)
+		case "tablespace":
+			return objectExistsInDB(db, database, "SELECT 1 FROM pg_catalog.pg_tablespace WHERE spcname = $1", name)
+		case "type", "domain":
+			return objectExistsInDB(db, database,
+				"SELECT 1 FROM pg_catalog.pg_type t JOIN pg_catalog.pg_namespace n ON n.oid = t.typnamespace "+
+					"WHERE n.nspname = $1 AND t.typname = $2",
+				matched[2], name,
+			)
+		case "language":
+			return objectExistsInDB(db, database, "SELECT 1 FROM pg_catalog.pg_language WHERE lanname = $1", name)
+		case "large_object":
+			return objectExistsInDB(db, database, "SELECT 1 FROM pg_catalog.pg_largeobject_metadata WHERE oid::text = $1", name)
+		case "parameter":
+			return objectExistsInDB(db, database,
+				"SELECT 1 FROM pg_catalog.pg_settings WHERE name = $1 "+
+					"UNION ALL SELECT 1 FROM pg_catalog.pg_parameter_acl WHERE parname = $1",
+				name,
+			)
+		}
+
+		// database and schema grants don't have objects.
+		return false, nil
+	}
+}
+
+// readColumnImportPrivilege returns the privilege granted to the role on the columns of the imported grant.
+// As a column grant manages exactly one privilege, the import fails if there isn't exactly one.
+func readColumnImportPrivilege(txn *sql.Tx, d *schema.ResourceData) (string, error) {
+	columns := []string{}
+	for _, column := range d.Get("columns").(*schema.Set).List() {
+		columns = append(columns, column.(string))
+	}
+
+	roleOID, err := getRoleOID(txn, d.Get("role").(string))
+	if err != nil {
+		return "", err
+	}
+
+	// The attacl column of pg_attribute contains information only about explicit column grants
+	query := `
+SELECT DISTINCT privilege_type
+FROM (SELECT attname, (aclexplode(attacl)).*
+      FROM pg_class
+               JOIN pg_namespace ON pg_class.relnamespace = pg_namespace.oid
+               JOIN pg_attribute ON pg_class.oid = attrelid
+      WHERE nspname = $2
+        AND relname = $3)
+         AS col_privs
+WHERE grantee = $1
+  AND attname = ANY($4)
+`
+	rows, err := txn.Query(
+		query, roleOID, d.Get("schema"), d.Get("objects").(*schema.Set).List()[0], pq.Array(columns),
+	)
+	if err != nil {
+		return "", fmt.Errorf("could not read column privileges: %w", err)
+	}
+	defer rows.Close()
+
+	var privileges []string
+	for rows.Next() {
+		var privilege string
+		if err := rows.Scan(&privilege); err != nil {
+			return "", fmt.Errorf("could not scan column privilege: %w", err)
+		}
+		privileges = append(privileges, privilege)
+	}
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("could not read column privileges: %w", err)
+	}
+
+	if len(privileges) != 1 {
+		return "", fmt.Errorf("could not import column grant %s: expected exactly one privilege, found %v", d.Id(), privileges)
+	}
+
+	return privileges[0], nil
+}
+
 func resourcePostgreSQLGrantRead(db *DBConnection, d *schema.ResourceData) error {
 	if err := validateFeatureSupport(db, d); err != nil {
 		return fmt.Errorf("feature is not supported: %v", err)
@@ -141,33 +391,11 @@ func resourcePostgreSQLGrantCreateOrUpdate(db *DBConnection, d *schema.ResourceD
 		return fmt.Errorf("feature is not supported: %v", err)
 	}
 
-	// Validate parameters.
-	objectType := d.Get("object_type").(string)
-	if d.Get("schema").(string) == "" && !sliceContainsStr([]string{"database", "foreign_data_wrapper", "foreign_server"}, objectType) {
-		return fmt.Errorf("parameter 'schema' is mandatory for postgresql_grant resource")
-	}
-	if d.Get("objects").(*schema.Set).Len() > 0 && (objectType == "database" || objectType == "schema") {
-		return fmt.Errorf("cannot specify `objects` when `object_type` is `database` or `schema`")
-	}
-	if d.Get("columns").(*schema.Set).Len() > 0 && (objectType != "column") {
-		return fmt.Errorf("cannot specify `columns` when `object_type` is not `column`")
-	}
-	if d.Get("columns").(*schema.Set).Len() == 0 && (objectType == "column") {
-		return fmt.Errorf("must specify `columns` when `object_type` is `column`")
-	}
-	if d.Get("privileges").(*schema.Set).Len() != 1 && (objectType == "column") {
-		return fmt.Errorf("must specify exactly 1 `privileges` when `object_type` is `column`")
-	}
-	if (d.Get("objects").(*schema.Set).Len() != 1) && (objectType == "column") {
-		return fmt.Errorf("must specify exactly 1 table in the `objects` field when `object_type` is `column`")
-	}
-	if d.Get("objects").(*schema.Set).Len() != 1 && (objectType == "foreign_data_wrapper" || objectType == "foreign_server") {
-		return fmt.Errorf("one element must be specified in `objects` when `object_type` is `foreign_data_wrapper` or `foreign_server`")
-	}
-	if err := validatePrivileges(d); err != nil {
+	if err := validateGrant(d); err != nil {
 		return err
 	}
 
+	objectType := d.Get("object_type").(string)
 	database := d.Get("database").(string)
 
 	txn, err := startTransaction(db.client, database)
@@ -221,6 +449,46 @@ func resourcePostgreSQLGrantCreateOrUpdate(db *DBConnection, d *schema.ResourceD
 	return readRolePrivileges(txn, d)
 }
 
+// validateGrant checks the consistency of the grant parameters for its object type.
+func validateGrant(d *schema.ResourceData) error {
+	objectType := d.Get("object_type").(string)
+	if d.Get("schema").(string) == "" && !sliceContainsStr(grantObjectTypesWithoutSchema, objectType) {
+		return fmt.Errorf("parameter 'schema' is mandatory for postgresql_grant resource")
+	}
+	if d.Get("objects").(*schema.Set).Len() > 0 && (objectType == "database" || objectType == "schema") {
+		return fmt.Errorf("cannot specify `objects` when `object_type` is `database` or `schema`")
+	}
+	if d.Get("columns").(*schema.Set).Len() > 0 && (objectType != "column") {
+		return fmt.Errorf("cannot specify `columns` when `object_type` is not `column`")
+	}
+	if d.Get("columns").(*schema.Set).Len() == 0 && (objectType == "column") {
+		return fmt.Errorf("must specify `columns` when `object_type` is `column`")
+	}
+	if d.Get("privileges").(*schema.Set).Len() != 1 && (objectType == "column") {
+		return fmt.Errorf("must specify exactly 1 `privileges` when `object_type` is `column`")
+	}
+	if (d.Get("objects").(*schema.Set).Len() != 1) && (objectType == "column") {
+		return fmt.Errorf("must specify exactly 1 table in the `objects` field when `object_type` is `column`")
+	}
+	if d.Get("objects").(*schema.Set).Len() != 1 && (objectType == "foreign_data_wrapper" || objectType == "foreign_server") {
+		return fmt.Errorf("one element must be specified in `objects` when `object_type` is `foreign_data_wrapper` or `foreign_server`")
+	}
+	if d.Get("objects").(*schema.Set).Len() != 1 && objectType == "tablespace" {
+		return fmt.Errorf("one element must be specified in `objects` when `object_type` is `tablespace`")
+	}
+	if d.Get("objects").(*schema.Set).Len() == 0 && sliceContainsStr(grantObjectTypesWithObjects, objectType) {
+		return fmt.Errorf("at least one element must be specified in `objects` when `object_type` is `%s`", objectType)
+	}
+	if objectType == "large_object" {
+		for _, object := range d.Get("objects").(*schema.Set).List() {
+			if _, err := strconv.ParseUint(object.(string), 10, 32); err != nil {
+				return fmt.Errorf("large object %q is not a valid OID", object)
+			}
+		}
+	}
+	return validatePrivileges(d)
+}
+
 func resourcePostgreSQLGrantDelete(db *DBConnection, d *schema.ResourceData) error {
 	if err := validateFeatureSupport(db, d); err != nil {
 		return fmt.Errorf("feature is not supported: %v", err)
@@ -352,6 +620,172 @@ WHERE grantee = $2
 	return nil
 }
 
+func readTablespaceRolePrivileges(txn *sql.Tx, d *schema.ResourceData, roleOID uint32) error {
+	objects := d.Get("objects").(*schema.Set).List()
+	spcName := objects[0].(string)
+	query := `
+SELECT pg_catalog.array_agg(privilege_type)
+FROM (
+	SELECT (pg_catalog.aclexplode(spcacl)).* FROM pg_catalog.pg_tablespace WHERE spcname=$1
+) as privileges
+WHERE grantee = $2
+`
+
+	var privileges pq.ByteaArray
+	if err := txn.QueryRow(query, spcName, roleOID).Scan(&privileges); err != nil {
+		return fmt.Errorf("could not read privileges for tablespace %s: %w", spcName, err)
+	}
+
+	granted := pgArrayToSet(privileges)
+	if !resourcePrivilegesEqual(granted, d) {
+		return d.Set("privileges", granted)
+	}
+	return nil
+}
+
+func readTypeRolePrivileges(txn *sql.Tx, d *schema.ResourceData, roleOID uint32) error {
+	// Domains are types, GRANT ON DOMAIN only accepts domains whereas GRANT ON TYPE accepts any type.
+	typTypes := []string{"b", "c", "d", "e", "m", "p", "r"}
+	if d.Get("object_type").(string) == "domain" {
+		typTypes = []string{"d"}
+	}
+
+	query := `
+SELECT o.name, pg_catalog.array_remove(pg_catalog.array_agg(acls.privilege_type), NULL)
+FROM pg_catalog.unnest($1::text[]) AS o(name)
+LEFT JOIN (
+	SELECT t.typname, (pg_catalog.aclexplode(t.typacl)).*
+	FROM pg_catalog.pg_type t
+	JOIN pg_catalog.pg_namespace n ON n.oid = t.typnamespace
+	WHERE n.nspname = $2 AND t.typtype::text = ANY($3)
+) acls ON acls.typname = o.name AND acls.grantee = $4
+GROUP BY o.name
+`
+	rows, err := txn.Query(query, pq.Array(grantObjectNames(d)), d.Get("schema").(string), pq.Array(typTypes), roleOID)
+	if err != nil {
+		return fmt.Errorf("could not read privileges for types: %w", err)
+	}
+
+	return readObjectsRolePrivileges(rows, d)
+}
+
+func readLanguageRolePrivileges(txn *sql.Tx, d *schema.ResourceData, roleOID uint32) error {
+	query := `
+SELECT o.name, pg_catalog.array_remove(pg_catalog.array_agg(acls.privilege_type), NULL)
+FROM pg_catalog.unnest($1::text[]) AS o(name)
+LEFT JOIN (
+	SELECT lanname, (pg_catalog.aclexplode(lanacl)).* FROM pg_catalog.pg_language
+) acls ON acls.lanname = o.name AND acls.grantee = $2
+GROUP BY o.name
+`
+	rows, err := txn.Query(query, pq.Array(grantObjectNames(d)), roleOID)
+	if err != nil {
+		return fmt.Errorf("could not read privileges for languages: %w", err)
+	}
+
+	return readObjectsRolePrivileges(rows, d)
+}
+
+func readLargeObjectRolePrivileges(txn *sql.Tx, d *schema.ResourceData, roleOID uint32) error {
+	query := `
+SELECT o.name, pg_catalog.array_remove(pg_catalog.array_agg(acls.privilege_type), NULL)
+FROM pg_catalog.unnest($1::text[]) AS o(name)
+LEFT JOIN (
+	SELECT oid, (pg_catalog.aclexplode(lomacl)).* FROM pg_catalog.pg_largeobject_metadata
+) acls ON acls.oid::text = o.name AND acls.grantee = $2
+GROUP BY o.name
+`
+	rows, err := txn.Query(query, pq.Array(grantObjectNames(d)), roleOID)
+	if err != nil {
+		return fmt.Errorf("could not read privileges for large objects: %w", err)
+	}
+
+	return readObjectsRolePrivileges(rows, d)
+}
+
+func readParameterRolePrivileges(txn *sql.Tx, d *schema.ResourceData, roleOID uint32) error {
+	// pg_parameter_acl only contains the parameters which have been granted at least once,
+	// with their names in lower case.
+	query := `
+SELECT o.name, pg_catalog.array_remove(pg_catalog.array_agg(acls.privilege_type), NULL)
+FROM pg_catalog.unnest($1::text[]) AS o(name)
+LEFT JOIN (
+	SELECT parname, (pg_catalog.aclexplode(paracl)).* FROM pg_catalog.pg_parameter_acl
+) acls ON acls.parname = pg_catalog.lower(o.name) AND acls.grantee = $2
+GROUP BY o.name
+`
+	rows, err := txn.Query(query, pq.Array(grantObjectNames(d)), roleOID)
+	if err != nil {
+		return fmt.Errorf("could not read privileges for parameters: %w", err)
+	}
+
+	return readObjectsRolePrivileges(rows, d)
+}
+
+// readObjectsRolePrivileges checks that every object returned by rows (name, privileges)
+// has the privileges saved in the state, otherwise the differing privileges are set.
+// The objects lacking some of these privileges are listed in objects_missing_privileges.
+func readObjectsRolePrivileges(rows *sql.Rows, d *schema.ResourceData) error {
+	defer rows.Close()
+
+	objectType := d.Get("object_type").(string)
+	var objectsPrivileges []*schema.Set
+	missing := []any{}
+	for rows.Next() {
+		var objName string
+		var privileges pq.ByteaArray
+
+		if err := rows.Scan(&objName, &privileges); err != nil {
+			return err
+		}
+
+		privilegesSet := pgArrayToSet(privileges)
+		if isMissingPrivileges(privilegesSet, d) {
+			missing = append(missing, objName)
+		}
+		if !resourcePrivilegesEqual(privilegesSet, d) {
+			log.Printf(
+				"[DEBUG] %s %s has not the expected privileges %v for role %s",
+				strings.ToTitle(objectType), objName, privileges, d.Get("role"),
+			)
+		}
+		objectsPrivileges = append(objectsPrivileges, privilegesSet)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	d.Set("objects_missing_privileges", schema.NewSet(schema.HashString, missing))
+	if differing := differingPrivileges(objectsPrivileges, d); differing != nil {
+		d.Set("privileges", differing)
+	}
+
+	return nil
+}
+
+// grantObjectNames returns the objects of the grant as a list of strings.
+func grantObjectNames(d *schema.ResourceData) []string {
+	var names []string
+	for _, object := range d.Get("objects").(*schema.Set).List() {
+		names = append(names, object.(string))
+	}
+	return names
+}
+
+// setToPgParameterList returns the list of parameters quoted for GRANT ... ON PARAMETER,
+// the parts of a customized option (e.g.: auto_explain.log_min_duration) are quoted separately.
+func setToPgParameterList(idents *schema.Set) string {
+	quotedIdents := make([]string, idents.Len())
+	for i, ident := range idents.List() {
+		parts := strings.Split(ident.(string), ".")
+		for j, part := range parts {
+			parts[j] = pq.QuoteIdentifier(part)
+		}
+		quotedIdents[i] = strings.Join(parts, ".")
+	}
+	return strings.Join(quotedIdents, ",")
+}
+
 func readColumnRolePrivileges(txn *sql.Tx, d *schema.ResourceData) error {
 	objects := d.Get("objects").(*schema.Set)
 
@@ -456,6 +890,21 @@ func readRolePrivileges(txn *sql.Tx, d *schema.ResourceData) error {
 	case "foreign_server":
 		return readForeignServerRolePrivileges(txn, d, roleOID)
 
+	case "tablespace":
+		return readTablespaceRolePrivileges(txn, d, roleOID)
+
+	case "type", "domain":
+		return readTypeRolePrivileges(txn, d, roleOID)
+
+	case "language":
+		return readLanguageRolePrivileges(txn, d, roleOID)
+
+	case "large_object":
+		return readLargeObjectRolePrivileges(txn, d, roleOID)
+
+	case "parameter":
+		return readParameterRolePrivileges(txn, d, roleOID)
+
 	case "function", "procedure", "routine":
 		query = `
 SELECT pg_proc.proname, array_remove(array_agg(privilege_type), NULL)
@@ -507,7 +956,10 @@ GROUP BY pg_class.relname
 	if err != nil {
 		return err
 	}
+	defer rows.Close()
 
+	var objectsPrivileges []*schema.Set
+	missing := []any{}
 	for rows.Next() {
 		var objName string
 		var privileges pq.ByteaArray
@@ -521,21 +973,70 @@ GROUP BY pg_class.relname
 		}
 
 		privilegesSet := pgArrayToSet(privileges)
+		if isMissingPrivileges(privilegesSet, d) {
+			missing = append(missing, objName)
+		}
 		if !resourcePrivilegesEqual(privilegesSet, d) {
-			// If any object doesn't have the same privileges as saved in the state,
-			// we return its privileges to force an update.
 			log.Printf(
 				"[DEBUG] %s %s has not the expected privileges %v for role %s",
 				strings.ToTitle(objectType), objName, privileges, d.Get("role"),
 			)
-			d.Set("privileges", privilegesSet)
-			break
 		}
+		objectsPrivileges = append(objectsPrivileges, privilegesSet)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	d.Set("objects_missing_privileges", schema.NewSet(schema.HashString, missing))
+	if differing := differingPrivileges(objectsPrivileges, d); differing != nil {
+		d.Set("privileges", differing)
 	}
 
 	return nil
 }
 
+// differingPrivileges returns the privileges to save in the state to force an update if some objects
+// don't have the same privileges as saved in the state, or nil if all of them have these privileges.
+// If some objects lack privileges, the intersection of the privileges of all the objects is returned
+// so the missing privileges are granted, otherwise the privileges of the first object having additional
+// privileges are returned so they are revoked.
+func differingPrivileges(objectsPrivileges []*schema.Set, d *schema.ResourceData) *schema.Set {
+	var intersection, additional *schema.Set
+	for _, privileges := range objectsPrivileges {
+		if intersection == nil {
+			intersection = privileges
+		} else {
+			intersection = intersection.Intersection(privileges)
+		}
+		if additional == nil && !resourcePrivilegesEqual(privileges, d) {
+			additional = privileges
+		}
+	}
+
+	if intersection != nil && !resourcePrivilegesEqual(intersection, d) {
+		return intersection
+	}
+	return additional
+}
+
+// isMissingPrivileges checks if some of the privileges saved in the state are not granted on an object,
+// the privileges granted in addition to these ones are ignored.
+func isMissingPrivileges(granted *schema.Set, d *schema.ResourceData) bool {
+	wanted := d.Get("privileges").(*schema.Set)
+	if wanted.Contains("ALL") {
+		implicits := []any{}
+		for _, p := range allowedPrivileges[d.Get("object_type").(string)] {
+			if p != "ALL" {
+				implicits = append(implicits, p)
+			}
+		}
+		wanted = schema.NewSet(schema.HashString, implicits)
+	}
+
+	return !resourcePrivilegesEqual(granted.Intersection(wanted), d)
+}
+
 func createGrantQuery(d *schema.ResourceData, privileges []string) string {
 	var query string
 
@@ -570,6 +1071,43 @@ func createGrantQuery(d *schema.ResourceData, privileges []string) string {
 			pq.QuoteIdentifier(srvName.(string)),
 			pq.QuoteIdentifier(d.Get("role").(string)),
 		)
+	case "TABLESPACE":
+		spcName := d.Get("objects").(*schema.Set).List()[0]
+		query = fmt.Sprintf(
+			"GRANT %s ON TABLESPACE %s TO %s",
+			strings.Join(privileges, ","),
+			pq.QuoteIdentifier(spcName.(string)),
+			pq.QuoteIdentifier(d.Get("role").(string)),
+		)
+	case "TYPE", "DOMAIN":
+		query = fmt.Sprintf(
+			"GRANT %s ON %s %s TO %s",
+			strings.Join(privileges, ","),
+			strings.ToUpper(d.Get("object_type").(string)),
+			setToPgIdentList(d.Get("schema").(string), d.Get("objects").(*schema.Set)),
+			pq.QuoteIdentifier(d.Get("role").(string)),
+		)
+	case "LANGUAGE":
+		query = fmt.Sprintf(
+			"GRANT %s ON LANGUAGE %s TO %s",
+			strings.Join(privileges, ","),
+			setToPgIdentListWithoutSchema(d.Get("objects").(*schema.Set)),
+			pq.QuoteIdentifier(d.Get("role").(string)),
+		)
+	case "LARGE_OBJECT":
+		query = fmt.Sprintf(
+			"GRANT %s ON LARGE OBJECT %s TO %s",
+			strings.Join(privileges, ","),
+			setToPgIdentSimpleList(d.Get("objects").(*schema.Set)),
+			pq.QuoteIdentifier(d.Get("role").(string)),
+		)
+	case "PARAMETER":
+		query = fmt.Sprintf(
+			"GRANT %s ON PARAMETER %s TO %s",
+			strings.Join(privileges, ","),
+			setToPgParameterList(d.Get("objects").(*schema.Set)),
+			pq.QuoteIdentifier(d.Get("role").(string)),
+		)
 	case "COLUMN":
 		objects := d.Get("objects").(*schema.Set)
 		query = fmt.Sprintf(
@@ -637,6 +1175,38 @@ func createRevokeQuery(getter ResourceSchemeGetter) string {
 			pq.QuoteIdentifier(srvName.(string)),
 			pq.QuoteIdentifier(getter("role").(string)),
 		)
+	case "TABLESPACE":
+		spcName := getter("objects").(*schema.Set).List()[0]
+		query = fmt.Sprintf(
+			"REVOKE ALL PRIVILEGES ON TABLESPACE %s FROM %s",
+			pq.QuoteIdentifier(spcName.(string)),
+			pq.QuoteIdentifier(getter("role").(string)),
+		)
+	case "TYPE", "DOMAIN":
+		query = fmt.Sprintf(
+			"REVOKE ALL PRIVILEGES ON %s %s FROM %s",
+			strings.ToUpper(getter("object_type").(string)),
+			setToPgIdentList(getter("schema").(string), getter("objects").(*schema.Set)),
+			pq.QuoteIdentifier(getter("role").(string)),
+		)
+	case "LANGUAGE":
+		query = fmt.Sprintf(
+			"REVOKE ALL PRIVILEGES ON LANGUAGE %s FROM %s",
+			setToPgIdentListWithoutSchema(getter("objects").(*schema.Set)),
+			pq.QuoteIdentifier(getter("role").(string)),
+		)
+	case "LARGE_OBJECT":
+		query = fmt.Sprintf(
+			"REVOKE ALL PRIVILEGES ON LARGE OBJECT %s FROM %s",
+			setToPgIdentSimpleList(getter("objects").(*schema.Set)),
+			pq.QuoteIdentifier(getter("role").(string)),
+		)
+	case "PARAMETER":
+		query = fmt.Sprintf(
+			"REVOKE ALL PRIVILEGES ON PARAMETER %s FROM %s",
+			setToPgParameterList(getter("objects").(*schema.Set)),
+			pq.QuoteIdentifier(getter("role").(string)),
+		)
 	case "COLUMN":
 		objects := getter("objects").(*schema.Set)
 		columns := getter("columns").(*schema.Set)
@@ -763,7 +1333,7 @@ func checkRoleDBSchemaExists(db *DBConnection, d *schema.ResourceData) (bool, er
 
 	// Check the schema exists (the SQL connection needs to be on the right database)
 	pgSchema := d.Get("schema").(string)
-	if !sliceContainsStr([]string{"database", "foreign_data_wrapper", "foreign_server"}, d.Get("object_type").(string)) && pgSchema != "" {
+	if !sliceContainsStr(grantObjectTypesWithoutSchema, d.Get("object_type").(string)) && pgSchema != "" {
 		exists, err = schemaExists(txn, pgSchema)
 		if err != nil {
 			return false, err
@@ -781,7 +1351,7 @@ func generateGrantID(d *schema.ResourceData) string {
 	parts := []string{d.Get("role").(string), d.Get("database").(string)}
 
 	objectType := d.Get("object_type").(string)
-	if objectType != "database" && objectType != "foreign_data_wrapper" && objectType != "foreign_server" {
+	if !sliceContainsStr(grantObjectTypesWithoutSchema, objectType) {
 		parts = append(parts, d.Get("schema").(string))
 	}
 	parts = append(parts, objectType)
@@ -802,9 +1372,9 @@ func getRolesToGrant(txn *sql.Tx, d *schema.ResourceData) ([]string, error) {
 	// we need to grant owner of the schema and owners of tables in the schema
 	// in order to change theirs permissions.
 	owners := []string{}
-	objectType := d.Get("object_type")
+	objectType := d.Get("object_type").(string)
 
-	if objectType == "database" || objectType == "foreign_data_wrapper" || objectType == "foreign_server" {
+	if sliceContainsStr(grantObjectTypesWithoutSchema, objectType) {
 		return owners, nil
 	}
 
@@ -853,5 +1423,11 @@ func validateFeatureSupport(db *DBConnection, d *schema.ResourceData) error {
 			db.version,
 		)
 	}
+	if d.Get("object_type") == "parameter" && !db.featureSupported(featureParameterPrivileges) {
+		return fmt.Errorf(
+			"object type PARAMETER is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
 	return nil
 }
@@ -23,14 +23,51 @@ FROM
 WHERE
   pg_get_userbyid(member) = $1 AND
   pg_get_userbyid(roleid) = $2;
+`
+
+	// This returns the role membership for role, grant_role with the options added in Postgres 16.
+	// There can be one membership per grantor, so the grantor can be filtered with $3.
+	getGrantRoleWithOptionsQuery = `
+SELECT
+  pg_get_userbyid(member) as role,
+  pg_get_userbyid(roleid) as grant_role,
+  admin_option,
+  inherit_option,
+  set_option,
+  pg_get_userbyid(grantor) as granted_by
+FROM
+  pg_auth_members
+WHERE
+  pg_get_userbyid(member) = $1 AND
+  pg_get_userbyid(roleid) = $2 AND
+  ($3 = '' OR pg_get_userbyid(grantor) = $3)
+ORDER BY grantor
+LIMIT 1;
 `
 )
 
+// roleMembershipOptions are the options of a role membership (GRANT role TO member WITH ...).
+// inherit and set are nil when they are not specified, so Postgres defaults apply.
+type roleMembershipOptions struct {
+	admin     bool
+	inherit   *bool
+	set       *bool
+	grantedBy string
+}
+
+// requiresFeature returns true if the options need featureRoleMembershipOptions.
+func (o roleMembershipOptions) requiresFeature() bool {
+	return o.inherit != nil || o.set != nil || o.grantedBy != ""
+}
+
 func resourcePostgreSQLGrantRole() *schema.Resource {
 	return &schema.Resource{
 		Create: PGResourceFunc(resourcePostgreSQLGrantRoleCreate),
 		Read:   PGResourceFunc(resourcePostgreSQLGrantRoleRead),
 		Delete: PGResourceFunc(resourcePostgreSQLGrantRoleDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: PGResourceImportFunc(resourcePostgreSQLGrantRoleImport),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role": {
@@ -52,6 +89,27 @@ func resourcePostgreSQLGrantRole() *schema.Resource {
 				Default:     false,
 				Description: "Permit the grant recipient to grant it to others",
 			},
+			"inherit_option": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Whether the member inherits the privileges of the granted role (defaults to the member's INHERIT attribute)",
+			},
+			"set_option": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Whether the member can SET ROLE to the granted role",
+			},
+			"granted_by": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The role recorded as the grantor of the membership",
+			},
 		},
 	}
 }
@@ -67,6 +125,49 @@ func resourcePostgreSQLGrantRoleRead(db *DBConnection, d *schema.ResourceData) e
 	return readGrantRole(db, d)
 }
 
+// resourcePostgreSQLGrantRoleImport parses the ID generated by generateGrantRoleID,
+// i.e.: role_grantrole_withadminoption and reads the role membership.
+func resourcePostgreSQLGrantRoleImport(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featurePrivileges) {
+		return fmt.Errorf(
+			"postgresql_grant_role resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	id := d.Id()
+	sep := strings.LastIndex(id, "_")
+	if sep == -1 {
+		return fmt.Errorf("grant role ID %s has not the expected format 'role_grantrole_withadminoption'", id)
+	}
+	if _, err := strconv.ParseBool(id[sep+1:]); err != nil {
+		return fmt.Errorf("grant role ID %s has not the expected format 'role_grantrole_withadminoption': %w", id, err)
+	}
+
+	parsed, err := splitImportID(id[:sep], []importIDMatcher{
+		importRoleMatcher(db, false),
+		importRoleMatcher(db, false),
+	}, nil)
+	if err != nil {
+		return err
+	}
+	if parsed == nil {
+		return fmt.Errorf("grant role ID %s does not match existing roles", id)
+	}
+
+	d.Set("role", parsed[0])
+	d.Set("grant_role", parsed[1])
+
+	if err := readGrantRole(db, d); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("role %s is not a member of role %s", parsed[0], parsed[1])
+	}
+
+	return nil
+}
+
 func resourcePostgreSQLGrantRoleCreate(db *DBConnection, d *schema.ResourceData) error {
 	if !db.featureSupported(featurePrivileges) {
 		return fmt.Errorf(
@@ -75,6 +176,13 @@ func resourcePostgreSQLGrantRoleCreate(db *DBConnection, d *schema.ResourceData)
 		)
 	}
 
+	if grantRoleMembershipOptions(d).requiresFeature() && !db.featureSupported(featureRoleMembershipOptions) {
+		return fmt.Errorf(
+			"inherit_option, set_option and granted_by are not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
 	txn, err := startTransaction(db.client, "")
 	if err != nil {
 		return err
@@ -124,9 +232,9 @@ func resourcePostgreSQLGrantRoleDelete(db *DBConnection, d *schema.ResourceData)
 	return nil
 }
 
-func readGrantRole(db QueryAble, d *schema.ResourceData) error {
-	var roleName, grantRoleName string
-	var withAdminOption bool
+func readGrantRole(db *DBConnection, d *schema.ResourceData) error {
+	var roleName, grantRoleName, grantedBy string
+	var withAdminOption, inheritOption, setOption bool
 
 	grantRoleID := d.Id()
 
@@ -136,7 +244,16 @@ func readGrantRole(db QueryAble, d *schema.ResourceData) error {
 		&withAdminOption,
 	}
 
-	err := db.QueryRow(getGrantRoleQuery, d.Get("role"), d.Get("grant_role")).Scan(values...)
+	query := getGrantRoleQuery
+	queryArgs := []any{d.Get("role"), d.Get("grant_role")}
+
+	if db.featureSupported(featureRoleMembershipOptions) {
+		query = getGrantRoleWithOptionsQuery
+		queryArgs = append(queryArgs, d.Get("granted_by"))
+		values = append(values, &inheritOption, &setOption, &grantedBy)
+	}
+
+	err := db.QueryRow(query, queryArgs...).Scan(values...)
 	switch {
 	case err == sql.ErrNoRows:
 		log.Printf("[WARN] PostgreSQL grant role (%q) not found", grantRoleID)
@@ -150,36 +267,92 @@ func readGrantRole(db QueryAble, d *schema.ResourceData) error {
 	d.Set("grant_role", grantRoleName)
 	d.Set("with_admin_option", withAdminOption)
 
+	if db.featureSupported(featureRoleMembershipOptions) {
+		d.Set("inherit_option", inheritOption)
+		d.Set("set_option", setOption)
+		d.Set("granted_by", grantedBy)
+	}
+
 	d.SetId(generateGrantRoleID(d))
 
 	return nil
 }
 
+// grantRoleMembershipOptions returns the membership options set in the configuration.
+func grantRoleMembershipOptions(d *schema.ResourceData) roleMembershipOptions {
+	options := roleMembershipOptions{
+		admin:     d.Get("with_admin_option").(bool),
+		grantedBy: d.Get("granted_by").(string),
+	}
+	if v, ok := d.GetOkExists("inherit_option"); ok { //nolint:staticcheck
+		inherit := v.(bool)
+		options.inherit = &inherit
+	}
+	if v, ok := d.GetOkExists("set_option"); ok { //nolint:staticcheck
+		set := v.(bool)
+		options.set = &set
+	}
+	return options
+}
+
 func createGrantRoleQuery(d *schema.ResourceData) string {
 	grantRole, _ := d.Get("grant_role").(string)
 	role, _ := d.Get("role").(string)
 
+	return createRoleMembershipQuery(grantRole, role, grantRoleMembershipOptions(d))
+}
+
+func createRevokeRoleQuery(d *schema.ResourceData) string {
+	grantRole, _ := d.Get("grant_role").(string)
+	role, _ := d.Get("role").(string)
+	grantedBy, _ := d.Get("granted_by").(string)
+
+	return createRevokeRoleMembershipQuery(grantRole, role, grantedBy)
+}
+
+// createRoleMembershipQuery returns the query granting grantRole to role with the given options.
+func createRoleMembershipQuery(grantRole, role string, options roleMembershipOptions) string {
 	query := fmt.Sprintf(
 		"GRANT %s TO %s",
 		pq.QuoteIdentifier(grantRole),
 		pq.QuoteIdentifier(role),
 	)
-	if wao, _ := d.Get("with_admin_option").(bool); wao {
-		query = query + " WITH ADMIN OPTION"
+
+	var withOptions []string
+	if options.admin {
+		withOptions = append(withOptions, "ADMIN OPTION")
+	}
+	if options.inherit != nil {
+		withOptions = append(withOptions, "INHERIT "+strings.ToUpper(strconv.FormatBool(*options.inherit)))
+	}
+	if options.set != nil {
+		withOptions = append(withOptions, "SET "+strings.ToUpper(strconv.FormatBool(*options.set)))
+	}
+	if len(withOptions) > 0 {
+		query = query + " WITH " + strings.Join(withOptions, ", ")
+	}
+
+	if options.grantedBy != "" {
+		query = query + " GRANTED BY " + pq.QuoteIdentifier(options.grantedBy)
 	}
 
 	return query
 }
 
-func createRevokeRoleQuery(d *schema.ResourceData) string {
-	grantRole, _ := d.Get("grant_role").(string)
-	role, _ := d.Get("role").(string)
-
-	return fmt.Sprintf(
+// createRevokeRoleMembershipQuery returns the query revoking grantRole from role,
+// for the membership granted by grantedBy if not empty.
+func createRevokeRoleMembershipQuery(grantRole, role, grantedBy string) string {
+	query := fmt.Sprintf(
 		"REVOKE %s FROM %s",
 		pq.QuoteIdentifier(grantRole),
 		pq.QuoteIdentifier(role),
 	)
+
+	if grantedBy != "" {
+		query = query + " GRANTED BY " + pq.QuoteIdentifier(grantedBy)
+	}
+
+	return query
 }
 
 func grantRole(txn *sql.Tx, d *schema.ResourceData) error {
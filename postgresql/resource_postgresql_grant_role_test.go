@@ -43,6 +43,25 @@ func TestCreateGrantRoleQuery(t *testing.T) {
 			},
 			expected: fmt.Sprintf("GRANT %s TO %s WITH ADMIN OPTION", pq.QuoteIdentifier(grantRoleName), pq.QuoteIdentifier(roleName)),
 		},
+		{
+			resource: map[string]any{
+				"role":              roleName,
+				"grant_role":        grantRoleName,
+				"with_admin_option": true,
+				"inherit_option":    false,
+				"set_option":        true,
+			},
+			expected: fmt.Sprintf("GRANT %s TO %s WITH ADMIN OPTION, INHERIT FALSE, SET TRUE", pq.QuoteIdentifier(grantRoleName), pq.QuoteIdentifier(roleName)),
+		},
+		{
+			resource: map[string]any{
+				"role":       roleName,
+				"grant_role": grantRoleName,
+				"set_option": false,
+				"granted_by": "baz",
+			},
+			expected: fmt.Sprintf("GRANT %s TO %s WITH SET FALSE GRANTED BY %s", pq.QuoteIdentifier(grantRoleName), pq.QuoteIdentifier(roleName), pq.QuoteIdentifier("baz")),
+		},
 	}
 
 	for _, c := range cases {
@@ -90,6 +109,16 @@ func TestRevokeRoleQuery(t *testing.T) {
 			t.Fatalf("error matching output and expected: %#v vs %#v", out, expected)
 		}
 	}
+
+	out := createRevokeRoleQuery(schema.TestResourceDataRaw(t, resourcePostgreSQLGrantRole().Schema, map[string]any{
+		"role":       roleName,
+		"grant_role": grantRoleName,
+		"granted_by": "baz",
+	}))
+	expectedGrantedBy := expected + " GRANTED BY " + pq.QuoteIdentifier("baz")
+	if out != expectedGrantedBy {
+		t.Fatalf("error matching output and expected: %#v vs %#v", out, expectedGrantedBy)
+	}
 }
 
 func TestAccPostgresqlGrantRole(t *testing.T) {
@@ -135,6 +164,11 @@ func TestAccPostgresqlGrantRole(t *testing.T) {
 					checkGrantRole(t, dsn, roleName, grantedRoleName, true),
 				),
 			},
+			{
+				ResourceName:      "postgresql_grant_role.grant_role",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -170,3 +204,90 @@ func checkGrantRole(t *testing.T, dsn, role string, grantRole string, withAdmin
 		return nil
 	}
 }
+
+func TestAccPostgresqlGrantRole_MembershipOptions(t *testing.T) {
+	skipIfNotAcc(t)
+
+	config := getTestConfig(t)
+	dsn := config.connStr("postgres")
+
+	dbSuffix, teardown := setupTestDatabase(t, false, true)
+	defer teardown()
+
+	_, roleName := getTestDBNames(dbSuffix)
+
+	grantedRoleName := "foo"
+
+	testAccPostgresqlGrantRoleResources := fmt.Sprintf(`
+	resource postgresql_role "grant" {
+		name = "%s"
+	}
+	resource postgresql_grant_role "grant_role" {
+		role           = "%s"
+		grant_role     = postgresql_role.grant.name
+		inherit_option = false
+		set_option     = true
+	}
+	`, grantedRoleName, roleName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureRoleMembershipOptions)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPostgresqlGrantRoleResources,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"postgresql_grant_role.grant_role", "inherit_option", "false"),
+					resource.TestCheckResourceAttr(
+						"postgresql_grant_role.grant_role", "set_option", "true"),
+					resource.TestCheckResourceAttrSet(
+						"postgresql_grant_role.grant_role", "granted_by"),
+					checkGrantRole(t, dsn, roleName, grantedRoleName, false),
+					checkGrantRoleOptions(t, dsn, roleName, grantedRoleName, false, true),
+				),
+			},
+			{
+				ResourceName:      "postgresql_grant_role.grant_role",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func checkGrantRoleOptions(t *testing.T, dsn, role string, grantRole string, inherit, set bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db, err := sql.Open("postgres", dsn)
+		if err != nil {
+			t.Fatalf("could to create connection pool: %v", err)
+		}
+		defer closeDB(t, db)
+
+		var _rez int
+		err = db.QueryRow(`
+		SELECT 1
+		FROM pg_auth_members
+		WHERE pg_get_userbyid(member) = $1
+		AND pg_get_userbyid(roleid) = $2
+		AND inherit_option = $3
+		AND set_option = $4;
+		`, role, grantRole, inherit, set).Scan(&_rez)
+
+		switch {
+		case err == sql.ErrNoRows:
+			return fmt.Errorf(
+				"Role %s is not a member of %s with inherit_option %t and set_option %t",
+				role, grantRole, inherit, set,
+			)
+
+		case err != nil:
+			t.Fatalf("could not check granted role options: %v", err)
+		}
+
+		return nil
+	}
+}
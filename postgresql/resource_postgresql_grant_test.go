@@ -167,6 +167,62 @@ func TestCreateGrantQuery(t *testing.T) {
 			privileges: []string{"ALL PRIVILEGES"},
 			expected:   fmt.Sprintf(`GRANT ALL PRIVILEGES ON FOREIGN SERVER "baz" TO %s WITH GRANT OPTION`, pq.QuoteIdentifier(roleName)),
 		},
+		{
+			resource: schema.TestResourceDataRaw(t, resourcePostgreSQLGrant().Schema, map[string]any{
+				"object_type": "tablespace",
+				"objects":     fdwObjects,
+				"role":        roleName,
+			}),
+			privileges: []string{"CREATE"},
+			expected:   fmt.Sprintf(`GRANT CREATE ON TABLESPACE "baz" TO %s`, pq.QuoteIdentifier(roleName)),
+		},
+		{
+			resource: schema.TestResourceDataRaw(t, resourcePostgreSQLGrant().Schema, map[string]any{
+				"object_type": "type",
+				"schema":      databaseName,
+				"objects":     fdwObjects,
+				"role":        roleName,
+			}),
+			privileges: []string{"USAGE"},
+			expected:   fmt.Sprintf(`GRANT USAGE ON TYPE %s."baz" TO %s`, pq.QuoteIdentifier(databaseName), pq.QuoteIdentifier(roleName)),
+		},
+		{
+			resource: schema.TestResourceDataRaw(t, resourcePostgreSQLGrant().Schema, map[string]any{
+				"object_type": "domain",
+				"schema":      databaseName,
+				"objects":     fdwObjects,
+				"role":        roleName,
+			}),
+			privileges: []string{"USAGE"},
+			expected:   fmt.Sprintf(`GRANT USAGE ON DOMAIN %s."baz" TO %s`, pq.QuoteIdentifier(databaseName), pq.QuoteIdentifier(roleName)),
+		},
+		{
+			resource: schema.TestResourceDataRaw(t, resourcePostgreSQLGrant().Schema, map[string]any{
+				"object_type": "language",
+				"objects":     []any{"plpgsql"},
+				"role":        roleName,
+			}),
+			privileges: []string{"USAGE"},
+			expected:   fmt.Sprintf(`GRANT USAGE ON LANGUAGE "plpgsql" TO %s`, pq.QuoteIdentifier(roleName)),
+		},
+		{
+			resource: schema.TestResourceDataRaw(t, resourcePostgreSQLGrant().Schema, map[string]any{
+				"object_type": "large_object",
+				"objects":     []any{"16400"},
+				"role":        roleName,
+			}),
+			privileges: []string{"SELECT", "UPDATE"},
+			expected:   fmt.Sprintf(`GRANT SELECT,UPDATE ON LARGE OBJECT 16400 TO %s`, pq.QuoteIdentifier(roleName)),
+		},
+		{
+			resource: schema.TestResourceDataRaw(t, resourcePostgreSQLGrant().Schema, map[string]any{
+				"object_type": "parameter",
+				"objects":     []any{"auto_explain.log_min_duration"},
+				"role":        roleName,
+			}),
+			privileges: []string{"SET", "ALTER SYSTEM"},
+			expected:   fmt.Sprintf(`GRANT SET,ALTER SYSTEM ON PARAMETER "auto_explain"."log_min_duration" TO %s`, pq.QuoteIdentifier(roleName)),
+		},
 	}
 
 	for _, c := range cases {
@@ -290,6 +346,47 @@ func TestCreateRevokeQuery(t *testing.T) {
 			}),
 			expected: fmt.Sprintf(`REVOKE ALL PRIVILEGES ON FOREIGN SERVER "baz" FROM %s`, pq.QuoteIdentifier(roleName)),
 		},
+		{
+			resource: schema.TestResourceDataRaw(t, resourcePostgreSQLGrant().Schema, map[string]any{
+				"object_type": "tablespace",
+				"objects":     fdwObjects,
+				"role":        roleName,
+			}),
+			expected: fmt.Sprintf(`REVOKE ALL PRIVILEGES ON TABLESPACE "baz" FROM %s`, pq.QuoteIdentifier(roleName)),
+		},
+		{
+			resource: schema.TestResourceDataRaw(t, resourcePostgreSQLGrant().Schema, map[string]any{
+				"object_type": "type",
+				"schema":      databaseName,
+				"objects":     fdwObjects,
+				"role":        roleName,
+			}),
+			expected: fmt.Sprintf(`REVOKE ALL PRIVILEGES ON TYPE %s."baz" FROM %s`, pq.QuoteIdentifier(databaseName), pq.QuoteIdentifier(roleName)),
+		},
+		{
+			resource: schema.TestResourceDataRaw(t, resourcePostgreSQLGrant().Schema, map[string]any{
+				"object_type": "language",
+				"objects":     []any{"plpgsql"},
+				"role":        roleName,
+			}),
+			expected: fmt.Sprintf(`REVOKE ALL PRIVILEGES ON LANGUAGE "plpgsql" FROM %s`, pq.QuoteIdentifier(roleName)),
+		},
+		{
+			resource: schema.TestResourceDataRaw(t, resourcePostgreSQLGrant().Schema, map[string]any{
+				"object_type": "large_object",
+				"objects":     []any{"16400"},
+				"role":        roleName,
+			}),
+			expected: fmt.Sprintf(`REVOKE ALL PRIVILEGES ON LARGE OBJECT 16400 FROM %s`, pq.QuoteIdentifier(roleName)),
+		},
+		{
+			resource: schema.TestResourceDataRaw(t, resourcePostgreSQLGrant().Schema, map[string]any{
+				"object_type": "parameter",
+				"objects":     []any{"work_mem"},
+				"role":        roleName,
+			}),
+			expected: fmt.Sprintf(`REVOKE ALL PRIVILEGES ON PARAMETER "work_mem" FROM %s`, pq.QuoteIdentifier(roleName)),
+		},
 	}
 
 	for _, c := range cases {
@@ -383,6 +480,83 @@ func TestAccPostgresqlGrant(t *testing.T) {
 	})
 }
 
+func TestAccPostgresqlGrantAllTablesNewObjects(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	testTables := []string{"test_schema.test_table", "test_schema.test_table2"}
+	createTestTables(t, dbSuffix, testTables, "")
+
+	dbName, roleName := getTestDBNames(dbSuffix)
+	config := getTestConfig(t)
+
+	var testGrant = fmt.Sprintf(`
+	resource "postgresql_grant" "test" {
+		database    = "%s"
+		role        = "%s"
+		schema      = "test_schema"
+		object_type = "table"
+		privileges  = ["SELECT"]
+	}
+	`, dbName, roleName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featurePrivileges)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testGrant,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grant.test", "privileges.#", "1"),
+					resource.TestCheckResourceAttr("postgresql_grant.test", "objects_missing_privileges.#", "0"),
+				),
+			},
+			{
+				// A table created after the grant lacks the privilege, which is detected as drift.
+				PreConfig: func() {
+					dbExecute(t, config.connStr(dbName), "CREATE TABLE test_schema.test_table_new (val text)")
+				},
+				Config:             testGrant,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testGrant,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grant.test", "privileges.#", "1"),
+					resource.TestCheckResourceAttr("postgresql_grant.test", "objects_missing_privileges.#", "0"),
+					func(*terraform.State) error {
+						return testCheckTablesPrivileges(t, dbName, roleName, []string{"test_schema.test_table_new"}, []string{"SELECT"})
+					},
+				),
+			},
+			{
+				// A privilege granted out of band on one of the tables is detected as drift.
+				PreConfig: func() {
+					dbExecute(t, config.connStr(dbName), fmt.Sprintf("GRANT INSERT ON test_schema.test_table TO %s", roleName))
+				},
+				Config:             testGrant,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testGrant,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grant.test", "privileges.#", "1"),
+					func(*terraform.State) error {
+						return testCheckTablesPrivileges(t, dbName, roleName, testTables, []string{"SELECT"})
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccPostgresqlGrantColumns(t *testing.T) {
 	skipIfNotAcc(t)
 
@@ -1127,6 +1301,11 @@ resource "postgresql_grant" "test" {
 					testCheckDatabasesPrivileges(t, true),
 				),
 			},
+			{
+				ResourceName:      "postgresql_grant.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Revoke
 			{
 				Config: fmt.Sprintf(config, "[]"),
@@ -1414,6 +1593,170 @@ resource "postgresql_grant" "test" {
 	})
 }
 
+func TestAccPostgresqlGrantTypeAndLanguage(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	dbName, roleName := getTestDBNames(dbSuffix)
+	config := getTestConfig(t)
+	dbExecute(t, config.connStr(dbName), "CREATE TYPE public.test_enum AS ENUM ('a', 'b')")
+	dbExecute(t, config.connStr(dbName), "CREATE DOMAIN public.test_domain AS integer CHECK (VALUE > 0)")
+
+	tfConfig := fmt.Sprintf(`
+resource "postgresql_grant" "type" {
+	database    = "%[1]s"
+	role        = "%[2]s"
+	schema      = "public"
+	object_type = "type"
+	objects     = ["test_enum"]
+	privileges  = %[3]s
+}
+
+resource "postgresql_grant" "domain" {
+	database    = "%[1]s"
+	role        = "%[2]s"
+	schema      = "public"
+	object_type = "domain"
+	objects     = ["test_domain"]
+	privileges  = %[3]s
+}
+
+resource "postgresql_grant" "language" {
+	database    = "%[1]s"
+	role        = "%[2]s"
+	object_type = "language"
+	objects     = ["plpgsql"]
+	privileges  = %[3]s
+}
+`, dbName, roleName, "%s")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featurePrivileges)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(tfConfig, `["USAGE"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grant.type", "id", fmt.Sprintf("%s_%s_public_type_test_enum", roleName, dbName)),
+					resource.TestCheckResourceAttr("postgresql_grant.type", "privileges.#", "1"),
+					resource.TestCheckResourceAttr("postgresql_grant.domain", "privileges.#", "1"),
+					resource.TestCheckResourceAttr("postgresql_grant.language", "id", fmt.Sprintf("%s_%s_language_plpgsql", roleName, dbName)),
+					resource.TestCheckResourceAttr("postgresql_grant.language", "privileges.#", "1"),
+					testCheckAclPrivilege(t, dbName, roleName, "SELECT typacl FROM pg_type WHERE typname = 'test_enum'", "USAGE", true),
+					testCheckAclPrivilege(t, dbName, roleName, "SELECT typacl FROM pg_type WHERE typname = 'test_domain'", "USAGE", true),
+					testCheckAclPrivilege(t, dbName, roleName, "SELECT lanacl FROM pg_language WHERE lanname = 'plpgsql'", "USAGE", true),
+				),
+			},
+			{
+				ResourceName:      "postgresql_grant.type",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(tfConfig, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grant.type", "privileges.#", "0"),
+					testCheckAclPrivilege(t, dbName, roleName, "SELECT typacl FROM pg_type WHERE typname = 'test_enum'", "USAGE", false),
+					testCheckAclPrivilege(t, dbName, roleName, "SELECT typacl FROM pg_type WHERE typname = 'test_domain'", "USAGE", false),
+					testCheckAclPrivilege(t, dbName, roleName, "SELECT lanacl FROM pg_language WHERE lanname = 'plpgsql'", "USAGE", false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlGrantLargeObject(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	dbName, roleName := getTestDBNames(dbSuffix)
+	config := getTestConfig(t)
+	dbExecute(t, config.connStr(dbName), "SELECT pg_catalog.lo_create(424242)")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featurePrivileges)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "postgresql_grant" "test" {
+	database    = "%s"
+	role        = "%s"
+	object_type = "large_object"
+	objects     = ["424242"]
+	privileges  = ["SELECT", "UPDATE"]
+}
+`, dbName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grant.test", "id", fmt.Sprintf("%s_%s_large_object_424242", roleName, dbName)),
+					resource.TestCheckResourceAttr("postgresql_grant.test", "privileges.#", "2"),
+					testCheckAclPrivilege(t, dbName, roleName, "SELECT lomacl FROM pg_largeobject_metadata WHERE oid = 424242", "UPDATE", true),
+				),
+			},
+			{
+				ResourceName:      "postgresql_grant.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlGrantParameter(t *testing.T) {
+	skipIfNotAcc(t)
+	skipIfNotSuperuser(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, false, true)
+	defer teardown()
+
+	_, roleName := getTestDBNames(dbSuffix)
+
+	tfConfig := fmt.Sprintf(`
+resource "postgresql_grant" "test" {
+	database    = "postgres"
+	role        = "%s"
+	object_type = "parameter"
+	objects     = ["log_min_duration_statement"]
+	privileges  = %%s
+}
+`, roleName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureParameterPrivileges)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(tfConfig, `["SET", "ALTER SYSTEM"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grant.test", "id", fmt.Sprintf("%s_postgres_parameter_log_min_duration_statement", roleName)),
+					resource.TestCheckResourceAttr("postgresql_grant.test", "privileges.#", "2"),
+					testCheckAclPrivilege(t, "postgres", roleName, "SELECT paracl FROM pg_parameter_acl WHERE parname = 'log_min_duration_statement'", "SET", true),
+				),
+			},
+			{
+				Config: fmt.Sprintf(tfConfig, `["SET"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grant.test", "privileges.#", "1"),
+					testCheckAclPrivilege(t, "postgres", roleName, "SELECT paracl FROM pg_parameter_acl WHERE parname = 'log_min_duration_statement'", "ALTER SYSTEM", false),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPostgresqlGrantOwnerPG15(t *testing.T) {
 	skipIfNotAcc(t)
 
@@ -1574,6 +1917,33 @@ func testCheckForeignDataWrapperPrivileges(t *testing.T, usage bool) func(*terra
 	}
 }
 
+// testCheckAclPrivilege checks if the role has the privilege in the ACL returned by aclQuery.
+func testCheckAclPrivilege(t *testing.T, dbName, roleName, aclQuery, privilege string, expected bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		config := getTestConfig(t)
+		db, err := sql.Open("postgres", config.connStr(dbName))
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		var granted bool
+		query := fmt.Sprintf(
+			"SELECT EXISTS (SELECT 1 FROM (SELECT (pg_catalog.aclexplode(acl)).* FROM (%s) AS objects(acl)) AS privileges "+
+				"WHERE grantee = (SELECT oid FROM pg_catalog.pg_roles WHERE rolname = $1) AND privilege_type = $2)",
+			aclQuery,
+		)
+		if err := db.QueryRow(query, roleName, privilege).Scan(&granted); err != nil {
+			return fmt.Errorf("could not check privilege %s of role %s: %w", privilege, roleName, err)
+		}
+
+		if granted != expected {
+			return fmt.Errorf("role %s has privilege %s: %t, expected %t", roleName, privilege, granted, expected)
+		}
+		return nil
+	}
+}
+
 func testCheckForeignServerPrivileges(t *testing.T, usage bool) func(*terraform.State) error {
 	return func(*terraform.State) error {
 		config := getTestConfig(t)
@@ -0,0 +1,591 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/lib/pq"
+)
+
+const (
+	grantsGrantAttr = "grant"
+
+	// bulkGrantsACLQuery lists the ACL entries of the given roles ($2) on the objects of the given schemas ($1)
+	// and on the named objects without schema ($3), with one row without grantee for objects without them.
+	// Functions are grouped by name as the postgresql_grant resource does.
+	bulkGrantsACLQuery = `
+WITH objects(object_type, schema_name, object_name, acl) AS (
+	SELECT CASE c.relkind WHEN 'S' THEN 'sequence' ELSE 'table' END, n.nspname::text, c.relname::text, c.relacl
+	FROM pg_catalog.pg_class c JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+	WHERE c.relkind IN ('r', 'S') AND n.nspname = ANY($1)
+	UNION ALL
+	SELECT 'function', n.nspname::text, p.proname::text, p.proacl
+	FROM pg_catalog.pg_proc p JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+	WHERE n.nspname = ANY($1)
+	UNION ALL
+	SELECT CASE t.typtype WHEN 'd' THEN 'domain' ELSE 'type' END, n.nspname::text, t.typname::text, t.typacl
+	FROM pg_catalog.pg_type t JOIN pg_catalog.pg_namespace n ON n.oid = t.typnamespace
+	WHERE n.nspname = ANY($1) AND t.typname = ANY($3)
+	UNION ALL
+	SELECT 'schema', nspname::text, nspname::text, nspacl FROM pg_catalog.pg_namespace WHERE nspname = ANY($1)
+	UNION ALL
+	SELECT 'database', '', datname::text, datacl FROM pg_catalog.pg_database WHERE datname = pg_catalog.current_database()
+	UNION ALL
+	SELECT 'foreign_data_wrapper', '', fdwname::text, fdwacl FROM pg_catalog.pg_foreign_data_wrapper WHERE fdwname = ANY($3)
+	UNION ALL
+	SELECT 'foreign_server', '', srvname::text, srvacl FROM pg_catalog.pg_foreign_server WHERE srvname = ANY($3)
+	UNION ALL
+	SELECT 'tablespace', '', spcname::text, spcacl FROM pg_catalog.pg_tablespace WHERE spcname = ANY($3)
+	UNION ALL
+	SELECT 'language', '', lanname::text, lanacl FROM pg_catalog.pg_language WHERE lanname = ANY($3)
+	UNION ALL
+	SELECT 'large_object', '', oid::text, lomacl FROM pg_catalog.pg_largeobject_metadata WHERE oid::text = ANY($3)
+	%s
+)
+SELECT o.object_type, o.schema_name, o.object_name, acls.grantee_name, acls.privilege_type
+FROM objects o
+LEFT JOIN LATERAL (
+	SELECT CASE WHEN e.grantee = 0 THEN 'public' ELSE r.rolname::text END AS grantee_name, e.privilege_type
+	FROM pg_catalog.aclexplode(o.acl) e
+	LEFT JOIN pg_catalog.pg_roles r ON r.oid = e.grantee
+) acls ON acls.grantee_name = ANY($2)
+ORDER BY 1, 2, 3
+`
+	bulkGrantsParameterACLQuery = `UNION ALL
+	SELECT 'parameter', '', parname::text, paracl FROM pg_catalog.pg_parameter_acl WHERE parname = ANY($3)`
+)
+
+func resourcePostgreSQLGrants() *schema.Resource {
+	return &schema.Resource{
+		Create: PGResourceFunc(resourcePostgreSQLGrantsCreate),
+		Read:   PGResourceFunc(resourcePostgreSQLGrantsRead),
+		Update: PGResourceFunc(resourcePostgreSQLGrantsUpdate),
+		Delete: PGResourceFunc(resourcePostgreSQLGrantsDelete),
+
+		Schema: map[string]*schema.Schema{
+			grantsGrantAttr: {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The database to grant privileges on for this role",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the role to grant privileges on",
+						},
+						"schema": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The database schema to grant privileges on for this role",
+						},
+						"object_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(grantsExclusiveObjectTypes, false),
+							Description:  "The PostgreSQL object type to grant the privileges on (one of: " + strings.Join(grantsExclusiveObjectTypes, ", ") + ")",
+						},
+						"objects": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The specific objects to grant privileges on for this role (empty means all objects of the requested type)",
+						},
+						"privileges": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The list of privileges to grant",
+						},
+						"with_grant_option": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Permit the grant recipient to grant it to others",
+						},
+					},
+				},
+				Description: "The grants to manage, applied in one transaction per database",
+			},
+		},
+	}
+}
+
+func resourcePostgreSQLGrantsCreate(db *DBConnection, d *schema.ResourceData) error {
+	grants, err := validateBulkGrants(db, d.Get(grantsGrantAttr).(*schema.Set))
+	if err != nil {
+		return err
+	}
+
+	if err := applyBulkGrants(db, nil, grants); err != nil {
+		return err
+	}
+
+	d.SetId(id.UniqueId())
+
+	return resourcePostgreSQLGrantsReadImpl(db, d)
+}
+
+func resourcePostgreSQLGrantsRead(db *DBConnection, d *schema.ResourceData) error {
+	return resourcePostgreSQLGrantsReadImpl(db, d)
+}
+
+func resourcePostgreSQLGrantsUpdate(db *DBConnection, d *schema.ResourceData) error {
+	if _, err := validateBulkGrants(db, d.Get(grantsGrantAttr).(*schema.Set)); err != nil {
+		return err
+	}
+
+	// Only the grants which changed are applied: the previous ones are revoked before the new ones are granted,
+	// so a block whose privileges were reduced is correctly applied.
+	o, n := d.GetChange(grantsGrantAttr)
+	oldGrants, newGrants := o.(*schema.Set), n.(*schema.Set)
+
+	if err := applyBulkGrants(
+		db,
+		bulkGrantsData(oldGrants.Difference(newGrants)),
+		bulkGrantsData(newGrants.Difference(oldGrants)),
+	); err != nil {
+		return err
+	}
+
+	return resourcePostgreSQLGrantsReadImpl(db, d)
+}
+
+func resourcePostgreSQLGrantsDelete(db *DBConnection, d *schema.ResourceData) error {
+	if err := applyBulkGrants(db, bulkGrantsData(d.Get(grantsGrantAttr).(*schema.Set)), nil); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourcePostgreSQLGrantsReadImpl(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featurePrivileges) {
+		return fmt.Errorf(
+			"postgresql_grants resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	grantsByDatabase := groupBulkGrantsByDatabase(bulkGrantsData(d.Get(grantsGrantAttr).(*schema.Set)))
+
+	existingRoles, err := listExistingRoles(db, grantsByDatabase)
+	if err != nil {
+		return err
+	}
+
+	grants := []any{}
+	for _, database := range sortedKeys(grantsByDatabase) {
+		exists, err := dbExists(db, database)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			log.Printf("[WARN] PostgreSQL database (%s) of grants not found", database)
+			continue
+		}
+
+		txn, err := startTransaction(db.client, database)
+		if err != nil {
+			return err
+		}
+		acls, err := readBulkGrantsACLs(db, txn, grantsByDatabase[database])
+		deferredRollback(txn)
+		if err != nil {
+			return err
+		}
+
+		for _, grant := range grantsByDatabase[database] {
+			role := grant.Get("role").(string)
+			if role != publicRole && !existingRoles[role] {
+				log.Printf("[WARN] role %s of grant %s not found", role, generateGrantID(grant))
+				continue
+			}
+
+			pgSchema := grant.Get("schema").(string)
+			objectType := grant.Get("object_type").(string)
+			if !sliceContainsStr(grantObjectTypesWithoutSchema, objectType) && !acls.schemaExists(pgSchema) {
+				log.Printf("[WARN] schema %s of grant %s not found", pgSchema, generateGrantID(grant))
+				continue
+			}
+
+			acls.readPrivileges(grant)
+			grants = append(grants, map[string]any{
+				"database":          database,
+				"role":              role,
+				"schema":            pgSchema,
+				"object_type":       objectType,
+				"objects":           grant.Get("objects").(*schema.Set),
+				"privileges":        grant.Get("privileges").(*schema.Set),
+				"with_grant_option": grant.Get("with_grant_option").(bool),
+			})
+		}
+	}
+
+	d.Set(grantsGrantAttr, grants)
+
+	return nil
+}
+
+// validateBulkGrants validates each grant as the postgresql_grant resource does
+// and checks that a role is granted only once the privileges on the same objects.
+func validateBulkGrants(db *DBConnection, grants *schema.Set) ([]*schema.ResourceData, error) {
+	data := bulkGrantsData(grants)
+	ids := map[string]bool{}
+	for _, grant := range data {
+		if err := validateFeatureSupport(db, grant); err != nil {
+			return nil, fmt.Errorf("feature is not supported: %v", err)
+		}
+		if err := validateGrant(grant); err != nil {
+			return nil, fmt.Errorf("invalid grant %s: %w", generateGrantID(grant), err)
+		}
+
+		grantID := generateGrantID(grant)
+		if ids[grantID] {
+			return nil, fmt.Errorf("grant %s is specified more than once", grantID)
+		}
+		ids[grantID] = true
+	}
+	return data, nil
+}
+
+// applyBulkGrants revokes then grants the privileges in one transaction per database.
+// The databases and the grants are processed in a deterministic order.
+func applyBulkGrants(db *DBConnection, revokes, grants []*schema.ResourceData) error {
+	revokesByDatabase := groupBulkGrantsByDatabase(revokes)
+	grantsByDatabase := groupBulkGrantsByDatabase(grants)
+
+	databases := map[string]bool{}
+	for database := range revokesByDatabase {
+		databases[database] = true
+	}
+	for database := range grantsByDatabase {
+		databases[database] = true
+	}
+
+	for _, database := range sortedKeys(databases) {
+		if err := applyDatabaseBulkGrants(db, database, revokesByDatabase[database], grantsByDatabase[database]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func applyDatabaseBulkGrants(db *DBConnection, database string, revokes, grants []*schema.ResourceData) error {
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	all := append(append([]*schema.ResourceData{}, revokes...), grants...)
+
+	// Each role and the database are locked once for the whole transaction, in the same order to avoid deadlocks.
+	roles := map[string]bool{}
+	lockDatabase := false
+	for _, grant := range all {
+		roles[grant.Get("role").(string)] = true
+		lockDatabase = lockDatabase || grant.Get("object_type").(string) == "database"
+	}
+	for _, role := range sortedKeys(roles) {
+		if err := pgLockRole(txn, role); err != nil {
+			return err
+		}
+	}
+	if lockDatabase {
+		if err := pgLockDatabase(txn, database); err != nil {
+			return err
+		}
+	}
+
+	owners, err := getBulkGrantsRolesToGrant(txn, all)
+	if err != nil {
+		return err
+	}
+
+	if err := withRolesGranted(txn, owners, func() error {
+		for _, grant := range revokes {
+			if _, err := txn.Exec(createRevokeQuery(grant.Get)); err != nil {
+				return fmt.Errorf("could not revoke grant %s: %w", generateGrantID(grant), err)
+			}
+		}
+		for _, grant := range grants {
+			// Revoke the privileges before granting them as postgresql_grant does,
+			// so the privileges are exactly the ones of the grant.
+			if _, err := txn.Exec(createRevokeQuery(grant.Get)); err != nil {
+				return fmt.Errorf("could not revoke grant %s: %w", generateGrantID(grant), err)
+			}
+			if err := grantRolePrivileges(txn, grant); err != nil {
+				return fmt.Errorf("could not apply grant %s: %w", generateGrantID(grant), err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("could not commit grants in database %s: %w", database, err)
+	}
+
+	return nil
+}
+
+// getBulkGrantsRolesToGrant returns the owners which have to be granted to apply the grants,
+// getRolesToGrant being called once per schema.
+func getBulkGrantsRolesToGrant(txn *sql.Tx, grants []*schema.ResourceData) ([]string, error) {
+	seen := map[string]bool{}
+	owners := []string{}
+	for _, grant := range grants {
+		key := fmt.Sprintf("%t_%s", grant.Get("object_type").(string) == "schema", grant.Get("schema").(string))
+		if sliceContainsStr(grantObjectTypesWithoutSchema, grant.Get("object_type").(string)) || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		schemaOwners, err := getRolesToGrant(txn, grant)
+		if err != nil {
+			return nil, err
+		}
+		for _, owner := range schemaOwners {
+			if !sliceContainsStr(owners, owner) {
+				owners = append(owners, owner)
+			}
+		}
+	}
+	return owners, nil
+}
+
+// bulkGrantObject identifies an object in the ACLs read by readBulkGrantsACLs.
+type bulkGrantObject struct {
+	objectType string
+	schema     string
+	name       string
+}
+
+// bulkGrantsACLs are the privileges of the roles per object of a database.
+type bulkGrantsACLs struct {
+	// objects lists the objects per object type and schema, in name order.
+	objects map[bulkGrantObject][]bulkGrantObject
+	// privileges are the privileges per object and role.
+	privileges map[bulkGrantObject]map[string][]any
+}
+
+// readBulkGrantsACLs reads with one query the ACLs of all the objects of the grants of a database.
+func readBulkGrantsACLs(db *DBConnection, txn *sql.Tx, grants []*schema.ResourceData) (*bulkGrantsACLs, error) {
+	schemas, roles, names := []string{}, []string{}, []string{}
+	for _, grant := range grants {
+		if s := grant.Get("schema").(string); s != "" && !sliceContainsStr(schemas, s) {
+			schemas = append(schemas, s)
+		}
+		if r := grant.Get("role").(string); !sliceContainsStr(roles, r) {
+			roles = append(roles, r)
+		}
+		for _, object := range grant.Get("objects").(*schema.Set).List() {
+			names = append(names, bulkGrantObjectName(grant.Get("object_type").(string), object.(string)))
+		}
+	}
+
+	parameterQuery := ""
+	if db.featureSupported(featureParameterPrivileges) {
+		parameterQuery = bulkGrantsParameterACLQuery
+	}
+
+	rows, err := txn.Query(fmt.Sprintf(bulkGrantsACLQuery, parameterQuery), pq.Array(schemas), pq.Array(roles), pq.Array(names))
+	if err != nil {
+		return nil, fmt.Errorf("could not read privileges: %w", err)
+	}
+	defer rows.Close()
+
+	acls := &bulkGrantsACLs{
+		objects:    map[bulkGrantObject][]bulkGrantObject{},
+		privileges: map[bulkGrantObject]map[string][]any{},
+	}
+	for rows.Next() {
+		var object bulkGrantObject
+		var grantee, privilege sql.NullString
+		if err := rows.Scan(&object.objectType, &object.schema, &object.name, &grantee, &privilege); err != nil {
+			return nil, fmt.Errorf("could not scan privileges: %w", err)
+		}
+
+		if _, ok := acls.privileges[object]; !ok {
+			acls.privileges[object] = map[string][]any{}
+			kind := bulkGrantObject{objectType: object.objectType, schema: object.schema}
+			acls.objects[kind] = append(acls.objects[kind], object)
+		}
+		if grantee.Valid {
+			acls.privileges[object][grantee.String] = append(acls.privileges[object][grantee.String], privilege.String)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read privileges: %w", err)
+	}
+
+	return acls, nil
+}
+
+func (acls *bulkGrantsACLs) schemaExists(name string) bool {
+	_, ok := acls.privileges[bulkGrantObject{objectType: "schema", schema: name, name: name}]
+	return ok
+}
+
+// readPrivileges sets the privileges of the grant as readRolePrivileges does for postgresql_grant.
+func (acls *bulkGrantsACLs) readPrivileges(grant *schema.ResourceData) {
+	objectType := grant.Get("object_type").(string)
+	role := grant.Get("role").(string)
+	pgSchema := grant.Get("schema").(string)
+	objects := grant.Get("objects").(*schema.Set)
+
+	var targets []bulkGrantObject
+	switch {
+	case objectType == "database":
+		targets = []bulkGrantObject{{objectType: objectType, name: grant.Get("database").(string)}}
+	case objectType == "schema":
+		targets = []bulkGrantObject{{objectType: objectType, schema: pgSchema, name: pgSchema}}
+	case objects.Len() == 0:
+		for _, aclType := range bulkGrantACLObjectTypes(objectType) {
+			targets = append(targets, acls.objects[bulkGrantObject{objectType: aclType, schema: pgSchema}]...)
+		}
+	default:
+		for _, object := range objects.List() {
+			targets = append(targets, acls.findObject(objectType, pgSchema, object.(string)))
+		}
+	}
+
+	var objectsPrivileges []*schema.Set
+	for _, target := range targets {
+		granted := schema.NewSet(schema.HashString, acls.privileges[target][role])
+		if !resourcePrivilegesEqual(granted, grant) {
+			log.Printf(
+				"[DEBUG] %s %s has not the expected privileges %v for role %s",
+				strings.ToTitle(objectType), target.name, granted.List(), role,
+			)
+		}
+		objectsPrivileges = append(objectsPrivileges, granted)
+	}
+
+	if differing := differingPrivileges(objectsPrivileges, grant); differing != nil {
+		grant.Set("privileges", differing)
+	}
+}
+
+// findObject returns the object of the ACLs matching a grant object, which has no privileges if it does not exist.
+func (acls *bulkGrantsACLs) findObject(objectType, pgSchema, name string) bulkGrantObject {
+	if sliceContainsStr(grantObjectTypesWithoutSchema, objectType) {
+		pgSchema = ""
+	}
+	name = bulkGrantObjectName(objectType, name)
+	aclTypes := bulkGrantACLObjectTypes(objectType)
+	for _, aclType := range aclTypes {
+		object := bulkGrantObject{objectType: aclType, schema: pgSchema, name: name}
+		if _, ok := acls.privileges[object]; ok {
+			return object
+		}
+	}
+	return bulkGrantObject{objectType: aclTypes[0], schema: pgSchema, name: name}
+}
+
+// bulkGrantACLObjectTypes returns the object types of bulkGrantsACLQuery matching a grant object type.
+func bulkGrantACLObjectTypes(objectType string) []string {
+	switch objectType {
+	case "function", "procedure", "routine":
+		return []string{"function"}
+	case "type":
+		// GRANT ON TYPE also applies to domains.
+		return []string{"type", "domain"}
+	}
+	return []string{objectType}
+}
+
+// bulkGrantObjectName returns the name of a grant object in the catalog,
+// i.e.: without the arguments of a function or in lower case for a parameter.
+func bulkGrantObjectName(objectType, name string) string {
+	switch objectType {
+	case "function", "procedure", "routine":
+		name, _, _ = strings.Cut(name, "(")
+	case "parameter":
+		name = strings.ToLower(name)
+	}
+	return name
+}
+
+// listExistingRoles returns the roles of the grants which exist.
+func listExistingRoles(db *DBConnection, grantsByDatabase map[string][]*schema.ResourceData) (map[string]bool, error) {
+	roles := []string{}
+	for _, grants := range grantsByDatabase {
+		for _, grant := range grants {
+			roles = append(roles, grant.Get("role").(string))
+		}
+	}
+
+	rows, err := db.Query("SELECT rolname FROM pg_catalog.pg_roles WHERE rolname = ANY($1)", pq.Array(roles))
+	if err != nil {
+		return nil, fmt.Errorf("could not read roles: %w", err)
+	}
+	defer rows.Close()
+
+	existing := map[string]bool{}
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, fmt.Errorf("could not scan role: %w", err)
+		}
+		existing[role] = true
+	}
+	return existing, rows.Err()
+}
+
+// bulkGrantsData returns the grant blocks as postgresql_grant resource data, sorted by grant ID,
+// so they can be validated, granted and revoked as the postgresql_grant resource does.
+func bulkGrantsData(grants *schema.Set) []*schema.ResourceData {
+	var data []*schema.ResourceData
+	for _, raw := range grants.List() {
+		grant := raw.(map[string]any)
+		gd := resourcePostgreSQLGrant().Data(nil)
+		gd.Set("database", grant["database"].(string))
+		gd.Set("role", grant["role"].(string))
+		gd.Set("schema", grant["schema"].(string))
+		gd.Set("object_type", grant["object_type"].(string))
+		gd.Set("objects", grant["objects"].(*schema.Set))
+		gd.Set("privileges", grant["privileges"].(*schema.Set))
+		gd.Set("with_grant_option", grant["with_grant_option"].(bool))
+		data = append(data, gd)
+	}
+
+	sort.Slice(data, func(i, j int) bool {
+		return generateGrantID(data[i]) < generateGrantID(data[j])
+	})
+	return data
+}
+
+func groupBulkGrantsByDatabase(grants []*schema.ResourceData) map[string][]*schema.ResourceData {
+	byDatabase := map[string][]*schema.ResourceData{}
+	for _, grant := range grants {
+		database := grant.Get("database").(string)
+		byDatabase[database] = append(byDatabase[database], grant)
+	}
+	return byDatabase
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,513 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/lib/pq"
+)
+
+const (
+	grantsExclusiveDatabaseAttr     = "database"
+	grantsExclusiveSchemaAttr       = "schema"
+	grantsExclusiveObjectTypeAttr   = "object_type"
+	grantsExclusiveObjectsAttr      = "objects"
+	grantsExclusiveGrantAttr        = "grant"
+	grantsExclusiveAllowedRolesAttr = "allowed_roles"
+)
+
+// grantsExclusiveObjectTypes are the object types which can be managed exclusively,
+// column privileges are excluded as they are not part of the object ACL.
+var grantsExclusiveObjectTypes = func() []string {
+	var objectTypes []string
+	for _, objectType := range allowedObjectTypes {
+		if objectType != "column" {
+			objectTypes = append(objectTypes, objectType)
+		}
+	}
+	return objectTypes
+}()
+
+func resourcePostgreSQLGrantsExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: PGResourceFunc(resourcePostgreSQLGrantsExclusiveCreate),
+		Read:   PGResourceFunc(resourcePostgreSQLGrantsExclusiveRead),
+		Update: PGResourceFunc(resourcePostgreSQLGrantsExclusiveUpdate),
+		Delete: PGResourceFunc(resourcePostgreSQLGrantsExclusiveDelete),
+
+		Schema: map[string]*schema.Schema{
+			grantsExclusiveDatabaseAttr: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The database of the objects to manage the privileges of",
+			},
+			grantsExclusiveSchemaAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The schema of the objects to manage the privileges of",
+			},
+			grantsExclusiveObjectTypeAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(grantsExclusiveObjectTypes, false),
+				Description:  "The PostgreSQL object type to manage the privileges of (one of: " + strings.Join(grantsExclusiveObjectTypes, ", ") + ")",
+			},
+			grantsExclusiveObjectsAttr: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The specific objects to manage the privileges of (empty means all objects of the requested type)",
+			},
+			grantsExclusiveGrantAttr: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the role to grant privileges to",
+						},
+						"privileges": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The list of privileges to grant",
+						},
+						"with_grant_option": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Permit the grant recipient to grant it to others",
+						},
+					},
+				},
+				Description: "The only privileges which should be granted on the objects, privileges of other roles are revoked",
+			},
+			grantsExclusiveAllowedRolesAttr: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The roles whose privileges are ignored (the owner of the objects and the superusers are always ignored)",
+			},
+		},
+	}
+}
+
+func resourcePostgreSQLGrantsExclusiveCreate(db *DBConnection, d *schema.ResourceData) error {
+	return resourcePostgreSQLGrantsExclusiveApply(db, d)
+}
+
+func resourcePostgreSQLGrantsExclusiveUpdate(db *DBConnection, d *schema.ResourceData) error {
+	return resourcePostgreSQLGrantsExclusiveApply(db, d)
+}
+
+func resourcePostgreSQLGrantsExclusiveRead(db *DBConnection, d *schema.ResourceData) error {
+	database := d.Get(grantsExclusiveDatabaseAttr).(string)
+	exists, err := dbExists(db, database)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		log.Printf("[WARN] PostgreSQL database (%s) for exclusive grants not found", database)
+		d.SetId("")
+		return nil
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	return readGrantsExclusive(txn, d)
+}
+
+func resourcePostgreSQLGrantsExclusiveApply(db *DBConnection, d *schema.ResourceData) error {
+	grants := exclusiveGrantsData(d)
+	for _, grant := range grants {
+		if err := validateFeatureSupport(db, grant); err != nil {
+			return fmt.Errorf("feature is not supported: %v", err)
+		}
+		if err := validateGrant(grant); err != nil {
+			return err
+		}
+	}
+	// The scope is validated even without any grant as all the privileges will be revoked.
+	if err := validateGrant(exclusiveGrantData(d, publicRole, nil)); err != nil {
+		return err
+	}
+
+	database := d.Get(grantsExclusiveDatabaseAttr).(string)
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if d.Get(grantsExclusiveObjectTypeAttr).(string) == "database" {
+		if err := pgLockDatabase(txn, database); err != nil {
+			return err
+		}
+	}
+
+	owners, err := getRolesToGrant(txn, exclusiveGrantData(d, publicRole, nil))
+	if err != nil {
+		return err
+	}
+
+	previousPrivileges := exclusiveGrantsPreviousPrivileges(d)
+	if err := withRolesGranted(txn, owners, func() error {
+		for _, grant := range grants {
+			role := grant.Get("role").(string)
+			if err := pgLockRole(txn, role); err != nil {
+				return err
+			}
+
+			// Revoke the privileges read previously so reducing privileges works,
+			// in the same transaction so the role does not lose them in between.
+			if previous, ok := previousPrivileges[role]; ok && previous.Len() > 0 {
+				revoke := exclusiveGrantData(d, role, previous)
+				if _, err := txn.Exec(createRevokeQuery(revoke.Get)); err != nil {
+					return fmt.Errorf("could not revoke privileges of role %s: %w", role, err)
+				}
+			}
+			if err := grantRolePrivileges(txn, grant); err != nil {
+				return fmt.Errorf("could not grant privileges to role %s: %w", role, err)
+			}
+		}
+
+		// The unmanaged grantees are listed after granting as the first GRANT on an object
+		// materializes its default privileges (e.g.: CONNECT for PUBLIC on a database).
+		unmanaged, err := listUnmanagedGrantees(txn, d)
+		if err != nil {
+			return err
+		}
+		for _, role := range unmanaged {
+			log.Printf("[DEBUG] Revoking privileges of unmanaged role %s", role)
+			revoke := exclusiveGrantData(d, role, nil)
+			if _, err := txn.Exec(createRevokeQuery(revoke.Get)); err != nil {
+				return fmt.Errorf("could not revoke privileges of unmanaged role %s: %w", role, err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	d.SetId(generateGrantsExclusiveID(d))
+
+	txn, err = startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	return readGrantsExclusive(txn, d)
+}
+
+func resourcePostgreSQLGrantsExclusiveDelete(db *DBConnection, d *schema.ResourceData) error {
+	database := d.Get(grantsExclusiveDatabaseAttr).(string)
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	owners, err := getRolesToGrant(txn, exclusiveGrantData(d, publicRole, nil))
+	if err != nil {
+		return err
+	}
+
+	// Only the managed privileges are revoked, the ignored roles keep theirs.
+	if err := withRolesGranted(txn, owners, func() error {
+		for _, grant := range exclusiveGrantsData(d) {
+			if grant.Get("privileges").(*schema.Set).Len() == 0 {
+				continue
+			}
+			if _, err := txn.Exec(createRevokeQuery(grant.Get)); err != nil {
+				return fmt.Errorf("could not revoke privileges of role %s: %w", grant.Get("role"), err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// readGrantsExclusive reads the privileges of the managed roles and of all the other grantees
+// which are not ignored, so the privileges granted out of band appear as drift.
+func readGrantsExclusive(txn *sql.Tx, d *schema.ResourceData) error {
+	configured := map[string]*schema.ResourceData{}
+	for _, grant := range exclusiveGrantsData(d) {
+		configured[grant.Get("role").(string)] = grant
+	}
+
+	unmanaged, err := listUnmanagedGrantees(txn, d)
+	if err != nil {
+		return err
+	}
+
+	roles := append([]string{}, unmanaged...)
+	for role := range configured {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	grants := []any{}
+	for _, role := range roles {
+		grant, ok := configured[role]
+		if !ok {
+			grant = exclusiveGrantData(d, role, nil)
+		}
+
+		if role != publicRole {
+			exists, err := roleExists(txn, role)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				log.Printf("[DEBUG] role %s does not exists", role)
+				continue
+			}
+		}
+
+		// The configured privileges are kept if they match the granted ones.
+		if err := readExclusiveGrantPrivileges(txn, d, grant); err != nil {
+			return err
+		}
+
+		privileges := grant.Get("privileges").(*schema.Set)
+		if privileges.Len() == 0 {
+			continue
+		}
+		grants = append(grants, map[string]any{
+			"role":              role,
+			"privileges":        privileges,
+			"with_grant_option": grant.Get("with_grant_option").(bool),
+		})
+	}
+
+	d.Set(grantsExclusiveGrantAttr, grants)
+	d.SetId(generateGrantsExclusiveID(d))
+
+	return nil
+}
+
+// readExclusiveGrantPrivileges reads the privileges of the role of the grant as readRolePrivileges does,
+// from the ACL returned by grantsExclusiveACLQuery so the default privileges of the objects are included.
+func readExclusiveGrantPrivileges(txn *sql.Tx, d *schema.ResourceData, grant *schema.ResourceData) error {
+	roleOID, err := getRoleOID(txn, grant.Get("role").(string))
+	if err != nil {
+		return err
+	}
+
+	aclQuery, args := grantsExclusiveACLQuery(d)
+	query := fmt.Sprintf(`
+SELECT objects.name, pg_catalog.array_remove(pg_catalog.array_agg(acls.privilege_type), NULL)
+FROM (%s) AS objects(name, acl, owner)
+LEFT JOIN LATERAL pg_catalog.aclexplode(objects.acl) acls ON acls.grantee = $%d
+GROUP BY objects.name
+`, aclQuery, len(args)+1)
+
+	rows, err := txn.Query(query, append(args, roleOID)...)
+	if err != nil {
+		return fmt.Errorf("could not read the privileges of role %s: %w", grant.Get("role"), err)
+	}
+
+	return readObjectsRolePrivileges(rows, grant)
+}
+
+// listUnmanagedGrantees returns the roles having privileges on the objects which are neither
+// managed by the resource nor ignored. The owners and the superusers are always ignored.
+func listUnmanagedGrantees(txn *sql.Tx, d *schema.ResourceData) ([]string, error) {
+	aclQuery, args := grantsExclusiveACLQuery(d)
+	query := fmt.Sprintf(`
+SELECT DISTINCT CASE WHEN acls.grantee = 0 THEN 'public' ELSE r.rolname::text END
+FROM (
+	SELECT (pg_catalog.aclexplode(objects.acl)).grantee, objects.owner FROM (%s) AS objects(name, acl, owner)
+) acls
+LEFT JOIN pg_catalog.pg_roles r ON r.oid = acls.grantee
+WHERE acls.grantee IS DISTINCT FROM acls.owner AND NOT COALESCE(r.rolsuper, false)
+`, aclQuery)
+
+	rows, err := txn.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not list the grantees of %s: %w", d.Get(grantsExclusiveObjectTypeAttr), err)
+	}
+	defer rows.Close()
+
+	managed := map[string]bool{}
+	for _, grant := range d.Get(grantsExclusiveGrantAttr).(*schema.Set).List() {
+		managed[grant.(map[string]any)["role"].(string)] = true
+	}
+	allowed := d.Get(grantsExclusiveAllowedRolesAttr).(*schema.Set)
+
+	var grantees []string
+	for rows.Next() {
+		var grantee string
+		if err := rows.Scan(&grantee); err != nil {
+			return nil, fmt.Errorf("could not scan grantee: %w", err)
+		}
+		if managed[grantee] || allowed.Contains(grantee) {
+			continue
+		}
+		grantees = append(grantees, grantee)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not list grantees: %w", err)
+	}
+
+	sort.Strings(grantees)
+	return grantees, nil
+}
+
+// grantsExclusiveACLQuery returns the query listing the name, the ACL and the owner of the objects of the resource.
+// A NULL ACL means that the objects have the default privileges, which are returned by acldefault
+// (e.g.: PUBLIC can connect to a database or execute a function).
+func grantsExclusiveACLQuery(d *schema.ResourceData) (string, []any) {
+	pgSchema := d.Get(grantsExclusiveSchemaAttr).(string)
+	objects := []string{}
+	for _, object := range d.Get(grantsExclusiveObjectsAttr).(*schema.Set).List() {
+		objects = append(objects, object.(string))
+	}
+
+	switch objectType := d.Get(grantsExclusiveObjectTypeAttr).(string); objectType {
+	case "database":
+		return "SELECT datname, COALESCE(datacl, pg_catalog.acldefault('d', datdba)), datdba FROM pg_catalog.pg_database WHERE datname = $1",
+			[]any{d.Get(grantsExclusiveDatabaseAttr).(string)}
+	case "schema":
+		return "SELECT nspname, COALESCE(nspacl, pg_catalog.acldefault('n', nspowner)), nspowner FROM pg_catalog.pg_namespace WHERE nspname = $1", []any{pgSchema}
+	case "function", "procedure", "routine":
+		// The objects can contain the arguments of the functions, e.g.: my_func(a integer)
+		names := make([]string, len(objects))
+		for i, object := range objects {
+			names[i], _, _ = strings.Cut(object, "(")
+		}
+		return "SELECT p.proname, COALESCE(p.proacl, pg_catalog.acldefault('f', p.proowner)), p.proowner FROM pg_catalog.pg_proc p " +
+				"JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace " +
+				"WHERE n.nspname = $1 AND (pg_catalog.cardinality($2::text[]) = 0 OR p.proname = ANY($2))",
+			[]any{pgSchema, pq.Array(names)}
+	case "foreign_data_wrapper":
+		return "SELECT fdwname, COALESCE(fdwacl, pg_catalog.acldefault('F', fdwowner)), fdwowner FROM pg_catalog.pg_foreign_data_wrapper WHERE fdwname = ANY($1)", []any{pq.Array(objects)}
+	case "foreign_server":
+		return "SELECT srvname, COALESCE(srvacl, pg_catalog.acldefault('S', srvowner)), srvowner FROM pg_catalog.pg_foreign_server WHERE srvname = ANY($1)", []any{pq.Array(objects)}
+	case "tablespace":
+		return "SELECT spcname, COALESCE(spcacl, pg_catalog.acldefault('t', spcowner)), spcowner FROM pg_catalog.pg_tablespace WHERE spcname = ANY($1)", []any{pq.Array(objects)}
+	case "type", "domain":
+		typTypeFilter := ""
+		if objectType == "domain" {
+			typTypeFilter = " AND t.typtype = 'd'"
+		}
+		return "SELECT t.typname, COALESCE(t.typacl, pg_catalog.acldefault('T', t.typowner)), t.typowner FROM pg_catalog.pg_type t " +
+				"JOIN pg_catalog.pg_namespace n ON n.oid = t.typnamespace " +
+				"WHERE n.nspname = $1 AND t.typname = ANY($2)" + typTypeFilter,
+			[]any{pgSchema, pq.Array(objects)}
+	case "language":
+		return "SELECT lanname, COALESCE(lanacl, pg_catalog.acldefault('l', lanowner)), lanowner FROM pg_catalog.pg_language WHERE lanname = ANY($1)", []any{pq.Array(objects)}
+	case "large_object":
+		return "SELECT oid::text, COALESCE(lomacl, pg_catalog.acldefault('L', lomowner)), lomowner FROM pg_catalog.pg_largeobject_metadata WHERE oid::text = ANY($1)", []any{pq.Array(objects)}
+	case "parameter":
+		// Parameters have no owner and only have a row in pg_parameter_acl once privileges are granted.
+		return "SELECT o.name, p.paracl, NULL::oid FROM pg_catalog.unnest($1::text[]) AS o(name) " +
+				"LEFT JOIN pg_catalog.pg_parameter_acl p ON p.parname = o.name",
+			[]any{pq.Array(lowerStrings(objects))}
+	default:
+		// Sequences have their own default privileges, the other relations have the ones of the tables.
+		return "SELECT c.relname, COALESCE(c.relacl, pg_catalog.acldefault(CASE WHEN c.relkind = 'S' THEN 's' ELSE 'r' END::\"char\", c.relowner)), c.relowner " +
+				"FROM pg_catalog.pg_class c " +
+				"JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace " +
+				"WHERE n.nspname = $1 AND c.relkind = $2 AND (pg_catalog.cardinality($3::text[]) = 0 OR c.relname = ANY($3))",
+			[]any{pgSchema, objectTypes[objectType], pq.Array(objects)}
+	}
+}
+
+// exclusiveGrantsData returns the configured grants as postgresql_grant resource data,
+// so they can be read, granted and revoked as the postgresql_grant resource does.
+func exclusiveGrantsData(d *schema.ResourceData) []*schema.ResourceData {
+	var grants []*schema.ResourceData
+	for _, raw := range d.Get(grantsExclusiveGrantAttr).(*schema.Set).List() {
+		grant := raw.(map[string]any)
+		data := exclusiveGrantData(d, grant["role"].(string), grant["privileges"].(*schema.Set))
+		data.Set("with_grant_option", grant["with_grant_option"].(bool))
+		grants = append(grants, data)
+	}
+	return grants
+}
+
+// exclusiveGrantData returns the postgresql_grant resource data of the role on the objects of the resource.
+func exclusiveGrantData(d *schema.ResourceData, role string, privileges *schema.Set) *schema.ResourceData {
+	if privileges == nil {
+		privileges = schema.NewSet(schema.HashString, []any{})
+	}
+
+	data := resourcePostgreSQLGrant().Data(nil)
+	data.Set("role", role)
+	data.Set("database", d.Get(grantsExclusiveDatabaseAttr).(string))
+	data.Set("schema", d.Get(grantsExclusiveSchemaAttr).(string))
+	data.Set("object_type", d.Get(grantsExclusiveObjectTypeAttr).(string))
+	data.Set("objects", d.Get(grantsExclusiveObjectsAttr).(*schema.Set))
+	data.Set("privileges", privileges)
+	data.Set("with_grant_option", false)
+	return data
+}
+
+// exclusiveGrantsPreviousPrivileges returns the privileges per role saved in the state before the change.
+func exclusiveGrantsPreviousPrivileges(d *schema.ResourceData) map[string]*schema.Set {
+	previous := map[string]*schema.Set{}
+	old, _ := d.GetChange(grantsExclusiveGrantAttr)
+	for _, raw := range old.(*schema.Set).List() {
+		grant := raw.(map[string]any)
+		previous[grant["role"].(string)] = grant["privileges"].(*schema.Set)
+	}
+	return previous
+}
+
+func generateGrantsExclusiveID(d *schema.ResourceData) string {
+	parts := []string{d.Get(grantsExclusiveDatabaseAttr).(string)}
+
+	objectType := d.Get(grantsExclusiveObjectTypeAttr).(string)
+	if !sliceContainsStr(grantObjectTypesWithoutSchema, objectType) {
+		parts = append(parts, d.Get(grantsExclusiveSchemaAttr).(string))
+	}
+	parts = append(parts, objectType)
+
+	objects := []string{}
+	for _, object := range d.Get(grantsExclusiveObjectsAttr).(*schema.Set).List() {
+		objects = append(objects, object.(string))
+	}
+	sort.Strings(objects)
+
+	return strings.Join(append(parts, objects...), "_")
+}
+
+func lowerStrings(values []string) []string {
+	lowered := make([]string, len(values))
+	for i, value := range values {
+		lowered[i] = strings.ToLower(value)
+	}
+	return lowered
+}
@@ -0,0 +1,283 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateGrantsExclusiveID(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     map[string]any
+		expected string
+	}{
+		{
+			name: "database",
+			data: map[string]any{
+				"database":    "test_db",
+				"object_type": "database",
+			},
+			expected: "test_db_database",
+		},
+		{
+			name: "tables",
+			data: map[string]any{
+				"database":    "test_db",
+				"schema":      "public",
+				"object_type": "table",
+				"objects":     []any{"t2", "t1"},
+			},
+			expected: "test_db_public_table_t1_t2",
+		},
+		{
+			name: "language",
+			data: map[string]any{
+				"database":    "test_db",
+				"object_type": "language",
+				"objects":     []any{"plpgsql"},
+			},
+			expected: "test_db_language_plpgsql",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourcePostgreSQLGrantsExclusive().Schema, tt.data)
+			assert.Equal(t, tt.expected, generateGrantsExclusiveID(d))
+		})
+	}
+}
+
+func TestAccPostgresqlGrantsExclusive(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	testTables := []string{"test_schema.test_table"}
+	createTestTables(t, dbSuffix, testTables, "")
+
+	dbName, roleName := getTestDBNames(dbSuffix)
+	otherRole := roleName + "_other"
+	defer createTestRole(t, otherRole)()
+
+	config := getTestConfig(t)
+	grantOutOfBand := func() {
+		dbExecute(t, config.connStr(dbName), fmt.Sprintf("GRANT SELECT ON test_schema.test_table TO %s", otherRole))
+	}
+	grantOutOfBand()
+
+	tfConfig := fmt.Sprintf(`
+resource "postgresql_grants_exclusive" "test" {
+	database      = "%s"
+	schema        = "test_schema"
+	object_type   = "table"
+	objects       = ["test_table"]
+	allowed_roles = %%s
+
+	grant {
+		role       = "%s"
+		privileges = ["SELECT"]
+	}
+}
+`, dbName, roleName)
+	aclQuery := "SELECT relacl FROM pg_class WHERE relname = 'test_table'"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featurePrivileges)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(tfConfig, "[]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grants_exclusive.test", "id", fmt.Sprintf("%s_test_schema_table_test_table", dbName)),
+					resource.TestCheckResourceAttr("postgresql_grants_exclusive.test", "grant.#", "1"),
+					func(*terraform.State) error {
+						return testCheckTablesPrivileges(t, dbName, roleName, testTables, []string{"SELECT"})
+					},
+					testCheckAclPrivilege(t, dbName, otherRole, aclQuery, "SELECT", false),
+				),
+			},
+			{
+				// Privileges granted out of band are detected as drift.
+				PreConfig:          grantOutOfBand,
+				Config:             fmt.Sprintf(tfConfig, "[]"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: fmt.Sprintf(tfConfig, "[]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grants_exclusive.test", "grant.#", "1"),
+					testCheckAclPrivilege(t, dbName, otherRole, aclQuery, "SELECT", false),
+				),
+			},
+			{
+				// The privileges of the allowed roles are kept.
+				PreConfig: grantOutOfBand,
+				Config:    fmt.Sprintf(tfConfig, fmt.Sprintf("[%q]", otherRole)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grants_exclusive.test", "grant.#", "1"),
+					testCheckAclPrivilege(t, dbName, otherRole, aclQuery, "SELECT", true),
+				),
+			},
+		},
+	})
+}
+
+// Test that the privileges granted out of band on one table are detected when managing all the tables of a schema.
+func TestAccPostgresqlGrantsExclusive_AllTables(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	testTables := []string{"test_schema.test_table", "test_schema.test_table2"}
+	createTestTables(t, dbSuffix, testTables, "")
+
+	dbName, roleName := getTestDBNames(dbSuffix)
+	otherRole := roleName + "_other"
+	defer createTestRole(t, otherRole)()
+
+	config := getTestConfig(t)
+
+	tfConfig := fmt.Sprintf(`
+resource "postgresql_grants_exclusive" "test" {
+	database    = "%s"
+	schema      = "test_schema"
+	object_type = "table"
+
+	grant {
+		role       = "%s"
+		privileges = ["SELECT"]
+	}
+}
+`, dbName, roleName)
+	aclQuery := "SELECT relacl FROM pg_class WHERE relname = 'test_table'"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featurePrivileges)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: tfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grants_exclusive.test", "grant.#", "1"),
+					func(*terraform.State) error {
+						return testCheckTablesPrivileges(t, dbName, roleName, testTables, []string{"SELECT"})
+					},
+				),
+			},
+			{
+				// The privilege granted to another role on only one of the tables is detected as drift.
+				PreConfig: func() {
+					dbExecute(t, config.connStr(dbName), fmt.Sprintf("GRANT SELECT ON test_schema.test_table TO %s", otherRole))
+				},
+				Config:             tfConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: tfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grants_exclusive.test", "grant.#", "1"),
+					testCheckAclPrivilege(t, dbName, otherRole, aclQuery, "SELECT", false),
+				),
+			},
+		},
+	})
+}
+
+// Test that the default privileges of PUBLIC (NULL ACL) are revoked as any other unmanaged privilege.
+func TestAccPostgresqlGrantsExclusive_DefaultACL(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	dbName, _ := getTestDBNames(dbSuffix)
+
+	config := getTestConfig(t)
+	createFunction := func() {
+		dbExecute(t, config.connStr(dbName), "CREATE FUNCTION test_schema.test_exclusive_func() RETURNS integer LANGUAGE sql AS 'SELECT 1'")
+	}
+	createFunction()
+
+	tfConfig := fmt.Sprintf(`
+resource "postgresql_grants_exclusive" "test" {
+	database    = "%s"
+	schema      = "test_schema"
+	object_type = "function"
+	objects     = ["test_exclusive_func"]
+}
+`, dbName)
+
+	checkPublicExecute := func(expected bool) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			db, err := sql.Open("postgres", config.connStr(dbName))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			var granted bool
+			if err := db.QueryRow(
+				"SELECT EXISTS (SELECT 1 FROM pg_catalog.pg_proc p, " +
+					"pg_catalog.aclexplode(COALESCE(p.proacl, pg_catalog.acldefault('f', p.proowner))) acl " +
+					"WHERE p.proname = 'test_exclusive_func' AND acl.grantee = 0 AND acl.privilege_type = 'EXECUTE')",
+			).Scan(&granted); err != nil {
+				return fmt.Errorf("could not check the privileges of PUBLIC: %w", err)
+			}
+			if granted != expected {
+				return fmt.Errorf("PUBLIC has privilege EXECUTE: %t, expected %t", granted, expected)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featurePrivileges)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: tfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grants_exclusive.test", "grant.#", "0"),
+					checkPublicExecute(false),
+				),
+			},
+			{
+				// The function is recreated without ACL, so PUBLIC can execute it again, which is detected as drift.
+				PreConfig: func() {
+					dbExecute(t, config.connStr(dbName), "DROP FUNCTION test_schema.test_exclusive_func()")
+					createFunction()
+				},
+				Config:             tfConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: tfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grants_exclusive.test", "grant.#", "0"),
+					checkPublicExecute(false),
+				),
+			},
+		},
+	})
+}
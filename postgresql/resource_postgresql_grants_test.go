@@ -0,0 +1,180 @@
+package postgresql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkGrantsACLsReadPrivileges(t *testing.T) {
+	table1 := bulkGrantObject{objectType: "table", schema: "public", name: "t1"}
+	table2 := bulkGrantObject{objectType: "table", schema: "public", name: "t2"}
+	function := bulkGrantObject{objectType: "function", schema: "public", name: "f"}
+	acls := &bulkGrantsACLs{
+		objects: map[bulkGrantObject][]bulkGrantObject{
+			{objectType: "table", schema: "public"}:    {table1, table2},
+			{objectType: "function", schema: "public"}: {function},
+		},
+		privileges: map[bulkGrantObject]map[string][]any{
+			table1:   {"app": {"SELECT", "INSERT"}, "public": {"SELECT"}},
+			table2:   {"app": {"SELECT"}},
+			function: {"app": {"EXECUTE"}},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		role       string
+		objectType string
+		objects    []any
+		privileges []any
+		expected   []string
+	}{
+		{
+			name:       "all tables with additional privileges on one table",
+			role:       "app",
+			objectType: "table",
+			privileges: []any{"SELECT"},
+			expected:   []string{"SELECT", "INSERT"},
+		},
+		{
+			name:       "all tables missing a privilege on one table",
+			role:       "app",
+			objectType: "table",
+			privileges: []any{"SELECT", "INSERT"},
+			expected:   []string{"SELECT"},
+		},
+		{
+			name:       "object with additional privileges",
+			role:       "app",
+			objectType: "table",
+			objects:    []any{"t2", "t1"},
+			privileges: []any{"SELECT"},
+			expected:   []string{"INSERT", "SELECT"},
+		},
+		{
+			name:       "public role",
+			role:       "public",
+			objectType: "table",
+			objects:    []any{"t1"},
+			privileges: []any{"SELECT"},
+			expected:   []string{"SELECT"},
+		},
+		{
+			name:       "function with arguments",
+			role:       "app",
+			objectType: "function",
+			objects:    []any{"f(integer)"},
+			privileges: []any{"EXECUTE"},
+			expected:   []string{"EXECUTE"},
+		},
+		{
+			name:       "missing object",
+			role:       "app",
+			objectType: "table",
+			objects:    []any{"unknown"},
+			privileges: []any{"SELECT"},
+			expected:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grant := resourcePostgreSQLGrant().Data(nil)
+			grant.Set("role", tt.role)
+			grant.Set("schema", "public")
+			grant.Set("object_type", tt.objectType)
+			grant.Set("objects", schema.NewSet(schema.HashString, tt.objects))
+			grant.Set("privileges", schema.NewSet(schema.HashString, tt.privileges))
+
+			acls.readPrivileges(grant)
+
+			privileges := []string{}
+			for _, p := range grant.Get("privileges").(*schema.Set).List() {
+				privileges = append(privileges, p.(string))
+			}
+			assert.ElementsMatch(t, tt.expected, privileges)
+		})
+	}
+}
+
+func TestAccPostgresqlGrants(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	testTables := []string{"test_schema.test_table", "test_schema.test_table2"}
+	createTestTables(t, dbSuffix, testTables, "")
+
+	dbName, roleName := getTestDBNames(dbSuffix)
+	config := getTestConfig(t)
+
+	tfConfig := fmt.Sprintf(`
+resource "postgresql_grants" "test" {
+	grant {
+		database    = "%[1]s"
+		role        = "%[2]s"
+		object_type = "database"
+		privileges  = ["CONNECT"]
+	}
+
+	grant {
+		database    = "%[1]s"
+		role        = "%[2]s"
+		schema      = "test_schema"
+		object_type = "table"
+		privileges  = %[3]s
+	}
+
+	grant {
+		database    = "%[1]s"
+		role        = "%[2]s"
+		schema      = "test_schema"
+		object_type = "schema"
+		privileges  = ["USAGE"]
+	}
+}
+`, dbName, roleName, "%s")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featurePrivileges)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(tfConfig, `["SELECT"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grants.test", "grant.#", "3"),
+					func(*terraform.State) error {
+						return testCheckTablesPrivileges(t, dbName, roleName, testTables, []string{"SELECT"})
+					},
+				),
+			},
+			{
+				// Privileges revoked out of band are detected as drift.
+				PreConfig: func() {
+					dbExecute(t, config.connStr(dbName), fmt.Sprintf("REVOKE SELECT ON test_schema.test_table2 FROM %s", roleName))
+				},
+				Config:             fmt.Sprintf(tfConfig, `["SELECT"]`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: fmt.Sprintf(tfConfig, `["SELECT", "INSERT"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grants.test", "grant.#", "3"),
+					func(*terraform.State) error {
+						return testCheckTablesPrivileges(t, dbName, roleName, testTables, []string{"SELECT", "INSERT"})
+					},
+				),
+			},
+		},
+	})
+}
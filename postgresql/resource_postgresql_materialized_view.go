@@ -0,0 +1,419 @@
+package postgresql
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/lib/pq"
+)
+
+const (
+	matViewTablespaceAttr  = "tablespace"
+	matViewWithDataAttr    = "with_data"
+	matViewUniqueIndexAttr = "unique_index"
+
+	matViewIndexNameAttr    = "name"
+	matViewIndexColumnsAttr = "columns"
+)
+
+func resourcePostgreSQLMaterializedView() *schema.Resource {
+	return &schema.Resource{
+		Create: PGResourceFunc(resourcePostgreSQLMaterializedViewCreate),
+		Read:   PGResourceFunc(resourcePostgreSQLMaterializedViewRead),
+		Update: PGResourceFunc(resourcePostgreSQLMaterializedViewUpdate),
+		Delete: PGResourceFunc(resourcePostgreSQLMaterializedViewDelete),
+		Exists: PGResourceExistsFunc(resourcePostgreSQLMaterializedViewExists),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			viewNameAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the materialized view",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			viewSchemaAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The schema where the materialized view is located. Defaults to public",
+			},
+			viewDatabaseAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The database where the materialized view is located. If not specified, the provider default database is used",
+			},
+			viewOwnerAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ROLE which owns the materialized view",
+			},
+			viewQueryAttr: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The SELECT, TABLE or VALUES query of the materialized view",
+
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return normalizeViewDefinition(old) == normalizeViewDefinition(new)
+				},
+			},
+			viewDefinitionAttr: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The normalized definition of the materialized view as returned by pg_get_viewdef",
+			},
+			matViewTablespaceAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The tablespace in which the materialized view is created. Defaults to the database default tablespace",
+			},
+			matViewWithDataAttr: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				Description: "If false, the materialized view is created unpopulated and must be refreshed before being queried",
+			},
+			matViewUniqueIndexAttr: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The unique indexes of the materialized view, required to refresh it concurrently",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						matViewIndexNameAttr: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The name of the index",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						matViewIndexColumnsAttr: {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The columns of the index",
+						},
+					},
+				},
+			},
+			viewDropCascadeAttr: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, will also drop all the objects that depend on the materialized view",
+			},
+		},
+	}
+}
+
+func resourcePostgreSQLMaterializedViewCreate(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureMaterializedView) {
+		return fmt.Errorf(
+			"postgresql_materialized_view resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	database := getDatabase(d, db.client.databaseName)
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	b := bytes.NewBufferString("CREATE MATERIALIZED VIEW ")
+	fmt.Fprint(b, getViewQuotedName(d))
+	if v, ok := d.GetOk(matViewTablespaceAttr); ok {
+		fmt.Fprint(b, " TABLESPACE ", pq.QuoteIdentifier(v.(string)))
+	}
+	fmt.Fprint(b, " AS ", strings.TrimSuffix(strings.TrimSpace(d.Get(viewQueryAttr).(string)), ";"))
+	if d.Get(matViewWithDataAttr).(bool) {
+		fmt.Fprint(b, " WITH DATA")
+	} else {
+		fmt.Fprint(b, " WITH NO DATA")
+	}
+
+	if _, err := txn.Exec(b.String()); err != nil {
+		return fmt.Errorf("error creating materialized view %s: %w", d.Get(viewNameAttr).(string), err)
+	}
+
+	if err := setMaterializedViewIndexes(txn, d); err != nil {
+		return err
+	}
+
+	if err := setViewOwner(txn, d, "MATERIALIZED VIEW"); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing materialized view: %w", err)
+	}
+
+	d.SetId(generateViewID(d, database))
+
+	return resourcePostgreSQLMaterializedViewReadImpl(db, d)
+}
+
+func resourcePostgreSQLMaterializedViewExists(db *DBConnection, d *schema.ResourceData) (bool, error) {
+	if !db.featureSupported(featureMaterializedView) {
+		return false, fmt.Errorf(
+			"postgresql_materialized_view resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	database, schemaName, viewName, err := getDBViewName(d, db.client.databaseName)
+	if err != nil {
+		return false, err
+	}
+
+	// Check if the database exists
+	exists, err := dbExists(db, database)
+	if err != nil || !exists {
+		return false, err
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return false, err
+	}
+	defer deferredRollback(txn)
+
+	return viewExists(txn, schemaName, viewName, "m")
+}
+
+func resourcePostgreSQLMaterializedViewRead(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureMaterializedView) {
+		return fmt.Errorf(
+			"postgresql_materialized_view resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	return resourcePostgreSQLMaterializedViewReadImpl(db, d)
+}
+
+func resourcePostgreSQLMaterializedViewReadImpl(db *DBConnection, d *schema.ResourceData) error {
+	database, schemaName, viewName, err := getDBViewName(d, db.client.databaseName)
+	if err != nil {
+		return err
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	var viewOID uint32
+	var viewOwner, viewDefinition, viewTablespace string
+
+	// reltablespace is 0 if the materialized view is in the default tablespace of the database (e.g.: pg_default).
+	query := `SELECT c.oid, pg_catalog.pg_get_userbyid(c.relowner), pg_catalog.pg_get_viewdef(c.oid), ` +
+		`COALESCE(t.spcname, dt.spcname) ` +
+		`FROM pg_catalog.pg_class c ` +
+		`JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace ` +
+		`LEFT JOIN pg_catalog.pg_tablespace t ON t.oid = c.reltablespace ` +
+		`JOIN pg_catalog.pg_database d ON d.datname = pg_catalog.current_database() ` +
+		`JOIN pg_catalog.pg_tablespace dt ON dt.oid = d.dattablespace ` +
+		`WHERE n.nspname = $1 AND c.relname = $2 AND c.relkind = 'm'`
+	err = txn.QueryRow(query, schemaName, viewName).Scan(&viewOID, &viewOwner, &viewDefinition, &viewTablespace)
+	switch {
+	case err == sql.ErrNoRows:
+		log.Printf("[WARN] PostgreSQL materialized view (%s.%s) not found in database %s", schemaName, viewName, database)
+		d.SetId("")
+		return nil
+	case err != nil:
+		return fmt.Errorf("error reading materialized view: %w", err)
+	}
+
+	indexes, err := readMaterializedViewIndexes(txn, d, viewOID)
+	if err != nil {
+		return err
+	}
+
+	d.Set(viewNameAttr, viewName)
+	d.Set(viewSchemaAttr, schemaName)
+	d.Set(viewDatabaseAttr, database)
+	d.Set(viewOwnerAttr, viewOwner)
+	d.Set(matViewTablespaceAttr, viewTablespace)
+	d.Set(matViewUniqueIndexAttr, indexes)
+	setViewQuery(d, viewDefinition)
+	d.SetId(generateViewID(d, database))
+
+	return nil
+}
+
+func readMaterializedViewIndexes(txn *sql.Tx, d *schema.ResourceData, viewOID uint32) ([]any, error) {
+	query := `SELECT i.relname, ` +
+		`ARRAY(SELECT a.attname FROM unnest(ix.indkey::SMALLINT[]) WITH ORDINALITY AS k(attnum, ord) ` +
+		`JOIN pg_catalog.pg_attribute a ON a.attrelid = ix.indrelid AND a.attnum = k.attnum ORDER BY k.ord)::TEXT[] ` +
+		`FROM pg_catalog.pg_index ix ` +
+		`JOIN pg_catalog.pg_class i ON i.oid = ix.indexrelid ` +
+		`WHERE ix.indrelid = $1 AND ix.indisunique ` +
+		`ORDER BY i.relname`
+
+	rows, err := txn.Query(query, viewOID)
+	if err != nil {
+		return nil, fmt.Errorf("could not read materialized view indexes: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("error closing rows: %v", err)
+		}
+	}()
+
+	indexes := []map[string]any{}
+	for rows.Next() {
+		var name string
+		var columnNames []string
+
+		if err := rows.Scan(&name, pq.Array(&columnNames)); err != nil {
+			return nil, fmt.Errorf("could not scan materialized view index: %w", err)
+		}
+
+		columns := make([]any, len(columnNames))
+		for i, c := range columnNames {
+			columns[i] = c
+		}
+
+		indexes = append(indexes, map[string]any{
+			matViewIndexNameAttr:    name,
+			matViewIndexColumnsAttr: columns,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("got rows.Err: %w", err)
+	}
+
+	return sortTableItemsLikeState(d.Get(matViewUniqueIndexAttr).([]any), indexes), nil
+}
+
+func resourcePostgreSQLMaterializedViewUpdate(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureMaterializedView) {
+		return fmt.Errorf(
+			"postgresql_materialized_view resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	database := getDatabase(d, db.client.databaseName)
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if err := setViewName(txn, d, database, "MATERIALIZED VIEW"); err != nil {
+		return err
+	}
+
+	if err := setMaterializedViewTablespace(txn, d); err != nil {
+		return err
+	}
+
+	if err := setMaterializedViewIndexes(txn, d); err != nil {
+		return err
+	}
+
+	if err := setViewOwner(txn, d, "MATERIALIZED VIEW"); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing materialized view: %w", err)
+	}
+
+	return resourcePostgreSQLMaterializedViewReadImpl(db, d)
+}
+
+func setMaterializedViewTablespace(txn *sql.Tx, d *schema.ResourceData) error {
+	if !d.HasChange(matViewTablespaceAttr) {
+		return nil
+	}
+
+	tablespace := d.Get(matViewTablespaceAttr).(string)
+	if tablespace == "" {
+		tablespace = "pg_default"
+	}
+
+	sql := fmt.Sprintf("ALTER MATERIALIZED VIEW %s SET TABLESPACE %s", getViewQuotedName(d), pq.QuoteIdentifier(tablespace))
+	if _, err := txn.Exec(sql); err != nil {
+		return fmt.Errorf("error updating materialized view TABLESPACE: %w", err)
+	}
+
+	return nil
+}
+
+// setMaterializedViewIndexes creates the new unique indexes and drops the removed ones.
+// A modified index is dropped and created again.
+func setMaterializedViewIndexes(txn *sql.Tx, d *schema.ResourceData) error {
+	if !d.HasChange(matViewUniqueIndexAttr) {
+		return nil
+	}
+
+	oraw, nraw := d.GetChange(matViewUniqueIndexAttr)
+	oldIndexes := tableItemsByName(oraw.([]any))
+	newIndexes := tableItemsByName(nraw.([]any))
+
+	schemaName := "public"
+	if v, ok := d.GetOk(viewSchemaAttr); ok {
+		schemaName = v.(string)
+	}
+
+	for name, oldIndex := range oldIndexes {
+		if newIndex, ok := newIndexes[name]; ok && reflect.DeepEqual(oldIndex, newIndex) {
+			continue
+		}
+
+		sql := fmt.Sprintf("DROP INDEX IF EXISTS %s.%s", pq.QuoteIdentifier(schemaName), pq.QuoteIdentifier(name))
+		if _, err := txn.Exec(sql); err != nil {
+			return fmt.Errorf("error dropping materialized view index %s: %w", name, err)
+		}
+	}
+
+	for _, i := range nraw.([]any) {
+		newIndex := i.(map[string]any)
+		name := newIndex[matViewIndexNameAttr].(string)
+		if oldIndex, ok := oldIndexes[name]; ok && reflect.DeepEqual(oldIndex, newIndex) {
+			continue
+		}
+
+		sql := fmt.Sprintf(
+			"CREATE UNIQUE INDEX %s ON %s (%s)",
+			pq.QuoteIdentifier(name), getViewQuotedName(d), tableQuotedColumns(newIndex[matViewIndexColumnsAttr]),
+		)
+		if _, err := txn.Exec(sql); err != nil {
+			return fmt.Errorf("error creating materialized view index %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func resourcePostgreSQLMaterializedViewDelete(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureMaterializedView) {
+		return fmt.Errorf(
+			"postgresql_materialized_view resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	return dropView(db, d, "MATERIALIZED VIEW", "m")
+}
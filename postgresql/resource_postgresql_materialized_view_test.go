@@ -0,0 +1,92 @@
+package postgresql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPostgresqlMaterializedView_Basic(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	dropTables := createTestTables(t, dbSuffix, []string{"test_matview_table"}, "")
+	defer dropTables()
+
+	dbName, roleName := getTestDBNames(dbSuffix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureMaterializedView)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlMaterializedViewDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccPostgresqlMaterializedViewConfig, dbName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlViewExists("postgresql_materialized_view.test", "m"),
+					resource.TestCheckResourceAttr("postgresql_materialized_view.test", "name", "test_matview"),
+					resource.TestCheckResourceAttr("postgresql_materialized_view.test", "owner", roleName),
+					resource.TestCheckResourceAttr("postgresql_materialized_view.test", "with_data", "false"),
+					// The default tablespace of the database is read
+					resource.TestCheckResourceAttr("postgresql_materialized_view.test", "tablespace", "pg_default"),
+					resource.TestCheckResourceAttr("postgresql_materialized_view.test", "unique_index.#", "1"),
+					resource.TestCheckResourceAttr("postgresql_materialized_view.test", "unique_index.0.name", "test_matview_val_idx"),
+					resource.TestCheckResourceAttr("postgresql_materialized_view.test", "unique_index.0.columns.0", "val"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccPostgresqlMaterializedViewConfigUpdated, dbName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlViewExists("postgresql_materialized_view.test", "m"),
+					resource.TestCheckResourceAttr("postgresql_materialized_view.test", "name", "test_matview_renamed"),
+					resource.TestCheckResourceAttr("postgresql_materialized_view.test", "tablespace", "pg_default"),
+					resource.TestCheckResourceAttr("postgresql_materialized_view.test", "unique_index.#", "1"),
+					resource.TestCheckResourceAttr("postgresql_materialized_view.test", "unique_index.0.name", "test_matview_val_one_idx"),
+					resource.TestCheckResourceAttr("postgresql_materialized_view.test", "unique_index.0.columns.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPostgresqlMaterializedViewDestroy(s *terraform.State) error {
+	return testAccCheckPostgresqlViewDestroyWithType(s, "postgresql_materialized_view", "m")
+}
+
+const testAccPostgresqlMaterializedViewConfig = `
+resource "postgresql_materialized_view" "test" {
+  database  = "%s"
+  name      = "test_matview"
+  owner     = "%s"
+  with_data = false
+  query     = "SELECT val, test_column_one FROM test_matview_table"
+
+  unique_index {
+    name    = "test_matview_val_idx"
+    columns = ["val"]
+  }
+}
+`
+
+const testAccPostgresqlMaterializedViewConfigUpdated = `
+resource "postgresql_materialized_view" "test" {
+  database  = "%s"
+  name       = "test_matview_renamed"
+  owner      = "%s"
+  with_data  = false
+  tablespace = "pg_default"
+  query      = "SELECT val, test_column_one FROM test_matview_table"
+
+  unique_index {
+    name    = "test_matview_val_one_idx"
+    columns = ["val", "test_column_one"]
+  }
+}
+`
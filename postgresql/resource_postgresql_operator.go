@@ -0,0 +1,379 @@
+package postgresql
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/lib/pq"
+)
+
+const (
+	opNameAttr        = "name"
+	opSchemaAttr      = "schema"
+	opDatabaseAttr    = "database"
+	opLeftTypeAttr    = "left_type"
+	opRightTypeAttr   = "right_type"
+	opFunctionAttr    = "function"
+	opCommutatorAttr  = "commutator"
+	opNegatorAttr     = "negator"
+	opRestrictAttr    = "restrict"
+	opJoinAttr        = "join"
+	opDropCascadeAttr = "drop_cascade"
+)
+
+var operatorNameRe = regexp.MustCompile("^[-+*/<>=~!@#%^&|`?]+$")
+
+func resourcePostgreSQLOperator() *schema.Resource {
+	return &schema.Resource{
+		Create: PGResourceFunc(resourcePostgreSQLOperatorCreate),
+		Read:   PGResourceFunc(resourcePostgreSQLOperatorRead),
+		Update: PGResourceFunc(resourcePostgreSQLOperatorUpdate),
+		Delete: PGResourceFunc(resourcePostgreSQLOperatorDelete),
+		Exists: PGResourceExistsFunc(resourcePostgreSQLOperatorExists),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			opNameAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the operator, e.g. ===",
+				ValidateFunc: validation.StringMatch(operatorNameRe, "must only contain the characters + - * / < > = ~ ! @ # % ^ & | ` ?"),
+			},
+			opSchemaAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Schema where the operator is located. Defaults to public.",
+			},
+			opDatabaseAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The database where the operator is located. If not specified, the provider default database is used.",
+			},
+			opLeftTypeAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The data type of the left operand. Not set for a prefix operator.",
+			},
+			opRightTypeAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The data type of the right operand.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			opFunctionAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The function used to implement the operator.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			opCommutatorAttr: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The commutator of the operator.",
+				ValidateFunc: validation.StringMatch(operatorNameRe, "must be an operator name"),
+			},
+			opNegatorAttr: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The negator of the operator.",
+				ValidateFunc: validation.StringMatch(operatorNameRe, "must be an operator name"),
+			},
+			opRestrictAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The restriction selectivity estimator function of the operator, e.g. eqsel",
+			},
+			opJoinAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The join selectivity estimator function of the operator, e.g. eqjoinsel",
+			},
+			opDropCascadeAttr: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Automatically drop objects that depend on the operator, and in turn all objects that depend on those objects.",
+			},
+		},
+	}
+}
+
+func resourcePostgreSQLOperatorCreate(db *DBConnection, d *schema.ResourceData) error {
+	database := getDatabase(d, db.client.databaseName)
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if _, err := txn.Exec(createOperatorQuery(d)); err != nil {
+		return fmt.Errorf("error creating operator %s: %w", d.Get(opNameAttr).(string), err)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing operator: %w", err)
+	}
+
+	d.SetId(generateOperatorID(d, database))
+
+	return resourcePostgreSQLOperatorReadImpl(db, d)
+}
+
+func resourcePostgreSQLOperatorExists(db *DBConnection, d *schema.ResourceData) (bool, error) {
+	database, signature, err := expandOperatorID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return false, err
+	}
+	defer deferredRollback(txn)
+
+	var operatorExists bool
+	if err := txn.QueryRow(
+		"SELECT EXISTS (SELECT 1 FROM pg_catalog.pg_operator WHERE oid = to_regoperator($1) AND oprcode::oid <> 0)",
+		signature,
+	).Scan(&operatorExists); err != nil {
+		return false, fmt.Errorf("could not check if operator exists: %w", err)
+	}
+
+	return operatorExists, nil
+}
+
+func resourcePostgreSQLOperatorRead(db *DBConnection, d *schema.ResourceData) error {
+	return resourcePostgreSQLOperatorReadImpl(db, d)
+}
+
+func resourcePostgreSQLOperatorReadImpl(db *DBConnection, d *schema.ResourceData) error {
+	database, signature, err := expandOperatorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	// Shell operators (created by a reference as commutator or negator) have no function.
+	query := `SELECT n.nspname, o.oprname, ` +
+		`CASE WHEN o.oprleft = 0 THEN '' ELSE o.oprleft::regtype::text END, ` +
+		`o.oprright::regtype::text, o.oprcode::regproc::text, ` +
+		`COALESCE((SELECT c.oprname FROM pg_catalog.pg_operator c WHERE c.oid = o.oprcom), ''), ` +
+		`COALESCE((SELECT c.oprname FROM pg_catalog.pg_operator c WHERE c.oid = o.oprnegate), ''), ` +
+		`CASE WHEN o.oprrest::oid = 0 THEN '' ELSE o.oprrest::regproc::text END, ` +
+		`CASE WHEN o.oprjoin::oid = 0 THEN '' ELSE o.oprjoin::regproc::text END ` +
+		`FROM pg_catalog.pg_operator o ` +
+		`JOIN pg_catalog.pg_namespace n ON n.oid = o.oprnamespace ` +
+		`WHERE o.oid = to_regoperator($1) AND o.oprcode::oid <> 0`
+
+	var schemaName, name, leftType, rightType, function, commutator, negator, restrict, join string
+	err = txn.QueryRow(query, signature).Scan(
+		&schemaName, &name, &leftType, &rightType, &function, &commutator, &negator, &restrict, &join,
+	)
+	switch {
+	case err == sql.ErrNoRows:
+		log.Printf("[WARN] PostgreSQL operator (%s) not found in database %s", signature, database)
+		d.SetId("")
+		return nil
+	case err != nil:
+		return fmt.Errorf("error reading operator: %w", err)
+	}
+
+	for attr, value := range map[string]*string{
+		opLeftTypeAttr:  &leftType,
+		opRightTypeAttr: &rightType,
+	} {
+		if *value, err = resolveCatalogName(txn, "regtype", d.Get(attr).(string), *value); err != nil {
+			return err
+		}
+	}
+	for attr, value := range map[string]*string{
+		opFunctionAttr: &function,
+		opRestrictAttr: &restrict,
+		opJoinAttr:     &join,
+	} {
+		if *value, err = resolveCatalogName(txn, "regproc", d.Get(attr).(string), *value); err != nil {
+			return err
+		}
+	}
+
+	d.Set(opDatabaseAttr, database)
+	d.Set(opSchemaAttr, schemaName)
+	d.Set(opNameAttr, name)
+	d.Set(opLeftTypeAttr, leftType)
+	d.Set(opRightTypeAttr, rightType)
+	d.Set(opFunctionAttr, function)
+	d.Set(opCommutatorAttr, commutator)
+	d.Set(opNegatorAttr, negator)
+	d.Set(opRestrictAttr, restrict)
+	d.Set(opJoinAttr, join)
+
+	return nil
+}
+
+func resourcePostgreSQLOperatorUpdate(db *DBConnection, d *schema.ResourceData) error {
+	if !d.HasChanges(opRestrictAttr, opJoinAttr) {
+		return resourcePostgreSQLOperatorReadImpl(db, d)
+	}
+
+	if !db.featureSupported(featureAlterOperator) {
+		return fmt.Errorf(
+			"updating restrict or join of an operator is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	database, signature, err := expandOperatorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if _, err := txn.Exec(alterOperatorQuery(d, signature)); err != nil {
+		return fmt.Errorf("error updating operator: %w", err)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing operator: %w", err)
+	}
+
+	return resourcePostgreSQLOperatorReadImpl(db, d)
+}
+
+func resourcePostgreSQLOperatorDelete(db *DBConnection, d *schema.ResourceData) error {
+	database, signature, err := expandOperatorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	dropMode := "RESTRICT"
+	if d.Get(opDropCascadeAttr).(bool) {
+		dropMode = "CASCADE"
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if _, err := txn.Exec(fmt.Sprintf("DROP OPERATOR IF EXISTS %s %s", signature, dropMode)); err != nil {
+		return fmt.Errorf("error deleting operator: %w", err)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing operator: %w", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func createOperatorQuery(d *schema.ResourceData) string {
+	b := bytes.NewBufferString("CREATE OPERATOR ")
+	fmt.Fprint(b, pq.QuoteIdentifier(getOperatorSchema(d)), ".", d.Get(opNameAttr).(string), " (")
+	fmt.Fprint(b, "\n    FUNCTION = ", d.Get(opFunctionAttr).(string))
+	if v, ok := d.GetOk(opLeftTypeAttr); ok {
+		fmt.Fprint(b, ",\n    LEFTARG = ", v.(string))
+	}
+	fmt.Fprint(b, ",\n    RIGHTARG = ", d.Get(opRightTypeAttr).(string))
+	if v, ok := d.GetOk(opCommutatorAttr); ok {
+		fmt.Fprint(b, ",\n    COMMUTATOR = ", v.(string))
+	}
+	if v, ok := d.GetOk(opNegatorAttr); ok {
+		fmt.Fprint(b, ",\n    NEGATOR = ", v.(string))
+	}
+	if v, ok := d.GetOk(opRestrictAttr); ok {
+		fmt.Fprint(b, ",\n    RESTRICT = ", v.(string))
+	}
+	if v, ok := d.GetOk(opJoinAttr); ok {
+		fmt.Fprint(b, ",\n    JOIN = ", v.(string))
+	}
+	b.WriteString("\n)")
+
+	return b.String()
+}
+
+func alterOperatorQuery(d *schema.ResourceData, signature string) string {
+	restrict := "NONE"
+	if v, ok := d.GetOk(opRestrictAttr); ok {
+		restrict = v.(string)
+	}
+	join := "NONE"
+	if v, ok := d.GetOk(opJoinAttr); ok {
+		join = v.(string)
+	}
+
+	return fmt.Sprintf("ALTER OPERATOR %s SET (RESTRICT = %s, JOIN = %s)", signature, restrict, join)
+}
+
+func getOperatorSchema(d *schema.ResourceData) string {
+	if v, ok := d.GetOk(opSchemaAttr); ok {
+		return v.(string)
+	}
+	return "public"
+}
+
+// generateOperatorID returns the ID database.schema.name(left_type,right_type),
+// the left type of a prefix operator is NONE.
+func generateOperatorID(d *schema.ResourceData, database string) string {
+	leftType := "NONE"
+	if v, ok := d.GetOk(opLeftTypeAttr); ok {
+		leftType = v.(string)
+	}
+
+	return fmt.Sprintf(
+		"%s.%s.%s(%s,%s)",
+		database, getOperatorSchema(d), d.Get(opNameAttr).(string), leftType, d.Get(opRightTypeAttr).(string),
+	)
+}
+
+// expandOperatorID returns the database and the signature of the operator from its ID
+// (e.g.: "public".===(integer,integer)), the signature is accepted by to_regoperator and DROP OPERATOR.
+func expandOperatorID(operatorID string) (string, string, error) {
+	parts := findStringSubmatchMap(
+		`^(?P<Database>[^.]+)\.(?P<Schema>[^.]+)\.(?P<Name>[^.(]+)\((?P<Left>[^,]+),(?P<Right>[^,]+)\)$`,
+		operatorID,
+	)
+	if len(parts) == 0 || parts["Database"] == "" {
+		return "", "", fmt.Errorf(
+			"operator ID %s has not the expected format 'database.schema.name(left_type,right_type)'", operatorID,
+		)
+	}
+
+	return parts["Database"], fmt.Sprintf(
+		"%s.%s(%s,%s)",
+		pq.QuoteIdentifier(parts["Schema"]), parts["Name"], strings.TrimSpace(parts["Left"]), strings.TrimSpace(parts["Right"]),
+	), nil
+}
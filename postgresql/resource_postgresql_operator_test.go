@@ -0,0 +1,297 @@
+package postgresql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccPostgresqlOperator_Basic(t *testing.T) {
+	configCreate := `
+resource "postgresql_operator" "equals" {
+    name       = "==="
+    left_type  = "int4"
+    right_type = "integer"
+    function   = "int4eq"
+    commutator = "==="
+    negator    = "!=="
+    restrict   = "eqsel"
+    join       = "eqjoinsel"
+}
+
+resource "postgresql_operator" "not_equals" {
+    name       = "!=="
+    left_type  = "integer"
+    right_type = "integer"
+    function   = "int4ne"
+    restrict   = "neqsel"
+    join       = "neqjoinsel"
+
+    depends_on = [postgresql_operator.equals]
+}
+`
+
+	configUpdate := `
+resource "postgresql_operator" "equals" {
+    name       = "==="
+    left_type  = "int4"
+    right_type = "integer"
+    function   = "int4eq"
+    commutator = "==="
+    negator    = "!=="
+}
+
+resource "postgresql_operator" "not_equals" {
+    name       = "!=="
+    left_type  = "integer"
+    right_type = "integer"
+    function   = "int4ne"
+    restrict   = "neqsel"
+    join       = "neqjoinsel"
+
+    depends_on = [postgresql_operator.equals]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureAlterOperator)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlOperatorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: configCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlOperatorExists("postgresql_operator.equals"),
+					testAccCheckPostgresqlOperatorExists("postgresql_operator.not_equals"),
+					resource.TestCheckResourceAttr("postgresql_operator.equals", "schema", "public"),
+					resource.TestCheckResourceAttr("postgresql_operator.equals", "left_type", "int4"),
+					resource.TestCheckResourceAttr("postgresql_operator.equals", "right_type", "integer"),
+					resource.TestCheckResourceAttr("postgresql_operator.equals", "function", "int4eq"),
+					resource.TestCheckResourceAttr("postgresql_operator.equals", "commutator", "==="),
+					resource.TestCheckResourceAttr("postgresql_operator.equals", "negator", "!=="),
+					resource.TestCheckResourceAttr("postgresql_operator.equals", "restrict", "eqsel"),
+					resource.TestCheckResourceAttr("postgresql_operator.equals", "join", "eqjoinsel"),
+					// The negator is set on both operators
+					resource.TestCheckResourceAttr("postgresql_operator.not_equals", "negator", "==="),
+				),
+			},
+			{
+				Config: configUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlOperatorExists("postgresql_operator.equals"),
+					resource.TestCheckResourceAttr("postgresql_operator.equals", "restrict", ""),
+					resource.TestCheckResourceAttr("postgresql_operator.equals", "join", ""),
+				),
+			},
+			{
+				ResourceName:            "postgresql_operator.not_equals",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{opDropCascadeAttr},
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlOperator_Prefix(t *testing.T) {
+	config := `
+resource "postgresql_function" "negate" {
+    name = "test_operator_negate"
+    arg {
+        type = "integer"
+    }
+    returns  = "integer"
+    language = "sql"
+    body     = "SELECT -$1"
+}
+
+resource "postgresql_operator" "negate" {
+    name       = "~~~"
+    right_type = "integer"
+    function   = postgresql_function.negate.name
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlOperatorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlOperatorExists("postgresql_operator.negate"),
+					resource.TestCheckResourceAttr("postgresql_operator.negate", "left_type", ""),
+					resource.TestCheckResourceAttr("postgresql_operator.negate", "function", "test_operator_negate"),
+				),
+			},
+			{
+				ResourceName:            "postgresql_operator.negate",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{opDropCascadeAttr},
+			},
+		},
+	})
+}
+
+func testAccCheckPostgresqlOperatorExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		exists, err := checkOperatorExists(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking operator %s", err)
+		}
+
+		if !exists {
+			return fmt.Errorf("Operator not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPostgresqlOperatorDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "postgresql_operator" {
+			continue
+		}
+
+		exists, err := checkOperatorExists(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking operator %s", err)
+		}
+
+		if exists {
+			return fmt.Errorf("Operator still exists after destroy")
+		}
+	}
+
+	return nil
+}
+
+func checkOperatorExists(operatorID string) (bool, error) {
+	client := testAccProvider.Meta().(*Client)
+
+	databaseName, signature, err := expandOperatorID(operatorID)
+	if err != nil {
+		return false, err
+	}
+
+	txn, err := startTransaction(client, databaseName)
+	if err != nil {
+		return false, err
+	}
+	defer deferredRollback(txn)
+
+	var exists bool
+	if err := txn.QueryRow(
+		"SELECT EXISTS (SELECT 1 FROM pg_catalog.pg_operator WHERE oid = to_regoperator($1))", signature,
+	).Scan(&exists); err != nil {
+		return false, fmt.Errorf("error reading info about operator: %w", err)
+	}
+
+	return exists, nil
+}
+
+func TestCreateOperatorQuery(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourcePostgreSQLOperator().Schema, map[string]any{
+		"name":       "===",
+		"schema":     "geo",
+		"left_type":  "point",
+		"right_type": "point",
+		"function":   "geo.point_eq",
+		"commutator": "===",
+		"negator":    "!==",
+		"restrict":   "eqsel",
+		"join":       "eqjoinsel",
+	})
+
+	assert.Equal(t, `CREATE OPERATOR "geo".=== (
+    FUNCTION = geo.point_eq,
+    LEFTARG = point,
+    RIGHTARG = point,
+    COMMUTATOR = ===,
+    NEGATOR = !==,
+    RESTRICT = eqsel,
+    JOIN = eqjoinsel
+)`, createOperatorQuery(d))
+	assert.Equal(t, "mydb.geo.===(point,point)", generateOperatorID(d, "mydb"))
+
+	d = schema.TestResourceDataRaw(t, resourcePostgreSQLOperator().Schema, map[string]any{
+		"name":       "~~~",
+		"right_type": "integer",
+		"function":   "my_negate",
+		"restrict":   "scalarltsel",
+	})
+
+	assert.Equal(t, `CREATE OPERATOR "public".~~~ (
+    FUNCTION = my_negate,
+    RIGHTARG = integer,
+    RESTRICT = scalarltsel
+)`, createOperatorQuery(d))
+	assert.Equal(t, "mydb.public.~~~(NONE,integer)", generateOperatorID(d, "mydb"))
+	assert.Equal(
+		t,
+		`ALTER OPERATOR "public".~~~(NONE,integer) SET (RESTRICT = scalarltsel, JOIN = NONE)`,
+		alterOperatorQuery(d, `"public".~~~(NONE,integer)`),
+	)
+}
+
+func TestExpandOperatorID(t *testing.T) {
+	cases := []struct {
+		id        string
+		database  string
+		signature string
+		wantErr   bool
+	}{
+		{
+			id:        "mydb.public.===(integer,integer)",
+			database:  "mydb",
+			signature: `"public".===(integer,integer)`,
+		},
+		{
+			id:        "mydb.geo.-(NONE,double precision)",
+			database:  "mydb",
+			signature: `"geo".-(NONE,double precision)`,
+		},
+		{
+			id:      "mydb.public.===(integer)",
+			wantErr: true,
+		},
+		{
+			id:      "public.===(integer,integer)",
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.id, func(t *testing.T) {
+			database, signature, err := expandOperatorID(c.id)
+			if c.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, c.database, database)
+			assert.Equal(t, c.signature, signature)
+		})
+	}
+}
@@ -3,13 +3,20 @@ package postgresql
 import (
 	"database/sql"
 	"fmt"
+	"log"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// physicalReplicationSlotTerminateTimeout is the time to wait for the walsender to exit after being terminated.
+const physicalReplicationSlotTerminateTimeout = 30 * time.Second
+
 func resourcePostgreSQLPhysicalReplicationSlot() *schema.Resource {
 	return &schema.Resource{
 		Create: PGResourceFunc(resourcePostgreSQLPhysicalReplicationSlotCreate),
 		Read:   PGResourceFunc(resourcePostgreSQLPhysicalReplicationSlotRead),
+		Update: PGResourceFunc(resourcePostgreSQLPhysicalReplicationSlotUpdate),
 		Delete: PGResourceFunc(resourcePostgreSQLPhysicalReplicationSlotDelete),
 		Exists: PGResourceExistsFunc(resourcePostgreSQLPhysicalReplicationSlotExists),
 		Importer: &schema.ResourceImporter{
@@ -22,19 +29,51 @@ func resourcePostgreSQLPhysicalReplicationSlot() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"immediately_reserve": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Reserves the WAL from the creation of the slot instead of the first connection of a streaming replication client",
+				// The value cannot be read back from the slot (e.g.: on import), so it is only used on creation.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
+			"terminate_active_on_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Terminates the walsender using the slot (e.g.: a connected standby) before dropping it",
+			},
+			"restart_lsn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The address of the oldest WAL which still might be required by the consumer of this slot",
+			},
+			"wal_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The availability of the WAL files claimed by this slot (reserved, extended, unreserved or lost)",
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if this slot is currently being used",
+			},
 		},
 	}
 }
 
 func resourcePostgreSQLPhysicalReplicationSlotCreate(db *DBConnection, d *schema.ResourceData) error {
 	name := d.Get("name").(string)
-	sql := "SELECT FROM pg_create_physical_replication_slot($1)"
-	if _, err := db.Exec(sql, name); err != nil {
+	sql := "SELECT FROM pg_create_physical_replication_slot($1, $2)"
+	if _, err := db.Exec(sql, name, d.Get("immediately_reserve").(bool)); err != nil {
 		return fmt.Errorf("could not create physical ReplicationSlot %s: %w", name, err)
 	}
 	d.SetId(name)
 
-	return nil
+	return resourcePostgreSQLPhysicalReplicationSlotRead(db, d)
 }
 
 func resourcePostgreSQLPhysicalReplicationSlotExists(db *DBConnection, d *schema.ResourceData) (bool, error) {
@@ -52,14 +91,48 @@ func resourcePostgreSQLPhysicalReplicationSlotExists(db *DBConnection, d *schema
 }
 
 func resourcePostgreSQLPhysicalReplicationSlotRead(db *DBConnection, d *schema.ResourceData) error {
+	var restartLSN, walStatus sql.NullString
+	var active bool
+	columns := "restart_lsn::text, active, NULL"
+	if db.featureSupported(featureReplicationSlotWALStatus) {
+		columns = "restart_lsn::text, active, wal_status"
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM pg_catalog.pg_replication_slots WHERE slot_name = $1 and slot_type = 'physical'", columns)
+	err := db.QueryRow(query, d.Id()).Scan(&restartLSN, &active, &walStatus)
+	switch {
+	case err == sql.ErrNoRows:
+		log.Printf("[WARN] PostgreSQL physical ReplicationSlot (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	case err != nil:
+		return fmt.Errorf("error reading physical ReplicationSlot: %w", err)
+	}
+
+	// immediately_reserve cannot be read back: a slot which is not reserved yet has no restart_lsn,
+	// but a slot created without reservation gets one when a standby connects.
 	d.Set("name", d.Id())
+	d.Set("restart_lsn", restartLSN.String)
+	d.Set("wal_status", walStatus.String)
+	d.Set("active", active)
 	return nil
 }
 
+func resourcePostgreSQLPhysicalReplicationSlotUpdate(db *DBConnection, d *schema.ResourceData) error {
+	// Only terminate_active_on_delete can be updated and it is only used on deletion.
+	return resourcePostgreSQLPhysicalReplicationSlotRead(db, d)
+}
+
 func resourcePostgreSQLPhysicalReplicationSlotDelete(db *DBConnection, d *schema.ResourceData) error {
 
 	replicationSlotName := d.Get("name").(string)
 
+	if d.Get("terminate_active_on_delete").(bool) {
+		if err := terminatePhysicalReplicationSlotWalSender(db, replicationSlotName); err != nil {
+			return err
+		}
+	}
+
 	if _, err := db.Exec("SELECT pg_drop_replication_slot($1)", replicationSlotName); err != nil {
 		return err
 	}
@@ -67,3 +140,33 @@ func resourcePostgreSQLPhysicalReplicationSlotDelete(db *DBConnection, d *schema
 	d.SetId("")
 	return nil
 }
+
+// terminatePhysicalReplicationSlotWalSender terminates the walsender using the slot and waits for the slot to be released.
+func terminatePhysicalReplicationSlotWalSender(db *DBConnection, slotName string) error {
+	deadline := time.Now().Add(physicalReplicationSlotTerminateTimeout)
+	for {
+		var activePID sql.NullInt64
+		err := db.QueryRow("SELECT active_pid FROM pg_catalog.pg_replication_slots WHERE slot_name = $1", slotName).Scan(&activePID)
+		switch {
+		case err == sql.ErrNoRows:
+			return nil
+		case err != nil:
+			return fmt.Errorf("could not read the active pid of physical ReplicationSlot %s: %w", slotName, err)
+		}
+
+		if !activePID.Valid {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("physical ReplicationSlot %s is still used by pid %d after terminating it", slotName, activePID.Int64)
+		}
+
+		log.Printf("[INFO] Terminating walsender %d using physical ReplicationSlot %s", activePID.Int64, slotName)
+		if _, err := db.Exec("SELECT pg_terminate_backend($1)", activePID.Int64); err != nil {
+			return fmt.Errorf("could not terminate walsender %d: %w", activePID.Int64, err)
+		}
+
+		// The standby may reconnect right away, the pid is checked again until the slot is released.
+		time.Sleep(500 * time.Millisecond)
+	}
+}
@@ -0,0 +1,84 @@
+package postgresql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccPostgresqlPhysicalReplicationSlot_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testSuperuserPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlPhysicalReplicationSlotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "postgresql_physical_replication_slot" "myslot" {
+					name                       = "physical_slot"
+					immediately_reserve        = true
+					terminate_active_on_delete = true
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"postgresql_physical_replication_slot.myslot", "name", "physical_slot"),
+					resource.TestCheckResourceAttr(
+						"postgresql_physical_replication_slot.myslot", "immediately_reserve", "true"),
+					resource.TestCheckResourceAttr(
+						"postgresql_physical_replication_slot.myslot", "active", "false"),
+					resource.TestCheckResourceAttrSet(
+						"postgresql_physical_replication_slot.myslot", "restart_lsn"),
+				),
+			},
+			{
+				ResourceName:      "postgresql_physical_replication_slot.myslot",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// These attributes cannot be read back from the slot
+				ImportStateVerifyIgnore: []string{"immediately_reserve", "terminate_active_on_delete"},
+			},
+		},
+	})
+}
+
+func TestPhysicalReplicationSlotImmediatelyReserveDiff(t *testing.T) {
+	immediatelyReserve := resourcePostgreSQLPhysicalReplicationSlot().Schema["immediately_reserve"]
+
+	d := resourcePostgreSQLPhysicalReplicationSlot().Data(nil)
+	assert.False(t, immediatelyReserve.DiffSuppressFunc("immediately_reserve", "", "true", d))
+
+	// Once the slot exists (e.g.: imported), the value is not compared as it cannot be read back.
+	d.SetId("physical_slot")
+	assert.True(t, immediatelyReserve.DiffSuppressFunc("immediately_reserve", "", "true", d))
+}
+
+func testAccCheckPostgresqlPhysicalReplicationSlotDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+	db, err := client.Connect()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "postgresql_physical_replication_slot" {
+			continue
+		}
+
+		var exists bool
+		if err := db.QueryRow("SELECT EXISTS (SELECT 1 FROM pg_catalog.pg_replication_slots WHERE slot_name = $1)", rs.Primary.ID).Scan(&exists); err != nil {
+			return fmt.Errorf("error checking physical replication slot %s", err)
+		}
+
+		if exists {
+			return fmt.Errorf("physical ReplicationSlot still exists after destroy")
+		}
+	}
+
+	return nil
+}
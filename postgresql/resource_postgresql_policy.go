@@ -0,0 +1,497 @@
+package postgresql
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/lib/pq"
+)
+
+const (
+	policyNameAttr      = "name"
+	policyDatabaseAttr  = "database"
+	policySchemaAttr    = "schema"
+	policyTableAttr     = "table"
+	policyAsAttr        = "as"
+	policyCommandAttr   = "command"
+	policyRolesAttr     = "roles"
+	policyUsingAttr     = "using"
+	policyWithCheckAttr = "with_check"
+
+	policyUsingDefinitionAttr     = "using_definition"
+	policyWithCheckDefinitionAttr = "with_check_definition"
+)
+
+// policyCommands maps the polcmd values of pg_policy to the command names.
+var policyCommands = map[string]string{
+	"*": "ALL",
+	"r": "SELECT",
+	"a": "INSERT",
+	"w": "UPDATE",
+	"d": "DELETE",
+}
+
+func resourcePostgreSQLPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: PGResourceFunc(resourcePostgreSQLPolicyCreate),
+		Read:   PGResourceFunc(resourcePostgreSQLPolicyRead),
+		Update: PGResourceFunc(resourcePostgreSQLPolicyUpdate),
+		Delete: PGResourceFunc(resourcePostgreSQLPolicyDelete),
+		Exists: PGResourceExistsFunc(resourcePostgreSQLPolicyExists),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			policyNameAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the policy",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			policyDatabaseAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The database of the table. If not specified, the provider default database is used",
+			},
+			policySchemaAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The schema of the table. Defaults to public",
+			},
+			policyTableAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the table to which the policy applies",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			policyAsAttr: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "PERMISSIVE",
+				ForceNew:     true,
+				Description:  "Whether the policy is permissive or restrictive. One of: PERMISSIVE, RESTRICTIVE",
+				ValidateFunc: validation.StringInSlice([]string{"PERMISSIVE", "RESTRICTIVE"}, false),
+			},
+			policyCommandAttr: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ALL",
+				ForceNew:     true,
+				Description:  "The command to which the policy applies. One of: ALL, SELECT, INSERT, UPDATE, DELETE",
+				ValidateFunc: validation.StringInSlice([]string{"ALL", "SELECT", "INSERT", "UPDATE", "DELETE"}, false),
+			},
+			policyRolesAttr: {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The roles to which the policy applies. Defaults to public",
+			},
+			policyUsingAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The expression added to the queries to filter the visible rows",
+			},
+			policyWithCheckAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The expression checked for the rows added or modified by INSERT and UPDATE queries",
+			},
+			policyUsingDefinitionAttr: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The USING expression as stored by PostgreSQL",
+			},
+			policyWithCheckDefinitionAttr: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The WITH CHECK expression as stored by PostgreSQL",
+			},
+		},
+	}
+}
+
+func resourcePostgreSQLPolicyCreate(db *DBConnection, d *schema.ResourceData) error {
+	if err := validatePolicyFeatureSupport(db, d); err != nil {
+		return err
+	}
+
+	database := getDatabase(d, db.client.databaseName)
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if err := createPolicy(txn, d); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing policy: %w", err)
+	}
+
+	d.SetId(generatePolicyID(d, database))
+
+	return resourcePostgreSQLPolicyReadImpl(db, d)
+}
+
+func createPolicy(txn *sql.Tx, d *schema.ResourceData) error {
+	b := bytes.NewBufferString("CREATE POLICY ")
+	fmt.Fprint(b, pq.QuoteIdentifier(d.Get(policyNameAttr).(string)), " ON ", getPolicyTableQuotedName(d))
+
+	// AS PERMISSIVE is the default and is not supported before PostgreSQL 10
+	if as := d.Get(policyAsAttr).(string); as != "PERMISSIVE" {
+		fmt.Fprint(b, " AS ", as)
+	}
+	fmt.Fprint(b, " FOR ", d.Get(policyCommandAttr).(string))
+
+	if roles := d.Get(policyRolesAttr).(*schema.Set); roles.Len() > 0 {
+		fmt.Fprint(b, " TO ", policyRolesList(roles))
+	}
+	if v, ok := d.GetOk(policyUsingAttr); ok {
+		fmt.Fprint(b, " USING (", v.(string), ")")
+	}
+	if v, ok := d.GetOk(policyWithCheckAttr); ok {
+		fmt.Fprint(b, " WITH CHECK (", v.(string), ")")
+	}
+
+	if _, err := txn.Exec(b.String()); err != nil {
+		return fmt.Errorf("error creating policy %s: %w", d.Get(policyNameAttr).(string), err)
+	}
+
+	return nil
+}
+
+func resourcePostgreSQLPolicyExists(db *DBConnection, d *schema.ResourceData) (bool, error) {
+	if !db.featureSupported(featureRLS) {
+		return false, fmt.Errorf(
+			"postgresql_policy resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	database, schemaName, tableName, policyName, err := getDBPolicyName(d, db.client.databaseName)
+	if err != nil {
+		return false, err
+	}
+
+	// Check if the database exists
+	exists, err := dbExists(db, database)
+	if err != nil || !exists {
+		return false, err
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return false, err
+	}
+	defer deferredRollback(txn)
+
+	var _rez bool
+	query := `SELECT TRUE FROM pg_catalog.pg_policy pol ` +
+		`JOIN pg_catalog.pg_class c ON c.oid = pol.polrelid ` +
+		`JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace ` +
+		`WHERE n.nspname = $1 AND c.relname = $2 AND pol.polname = $3`
+	err = txn.QueryRow(query, schemaName, tableName, policyName).Scan(&_rez)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("could not check if policy exists: %w", err)
+	}
+
+	return true, nil
+}
+
+func resourcePostgreSQLPolicyRead(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureRLS) {
+		return fmt.Errorf(
+			"postgresql_policy resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	return resourcePostgreSQLPolicyReadImpl(db, d)
+}
+
+func resourcePostgreSQLPolicyReadImpl(db *DBConnection, d *schema.ResourceData) error {
+	database, schemaName, tableName, policyName, err := getDBPolicyName(d, db.client.databaseName)
+	if err != nil {
+		return err
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	permissiveColumn := "TRUE"
+	if db.featureSupported(featureRestrictivePolicy) {
+		permissiveColumn = "pol.polpermissive"
+	}
+
+	var permissive bool
+	var command string
+	var roles []string
+	var using, withCheck sql.NullString
+
+	query := fmt.Sprintf(`SELECT %s, pol.polcmd, `+
+		`ARRAY(SELECT CASE WHEN r = 0 THEN 'public' ELSE pg_catalog.pg_get_userbyid(r) END FROM unnest(pol.polroles) AS r)::TEXT[], `+
+		`pg_catalog.pg_get_expr(pol.polqual, pol.polrelid), pg_catalog.pg_get_expr(pol.polwithcheck, pol.polrelid) `+
+		`FROM pg_catalog.pg_policy pol `+
+		`JOIN pg_catalog.pg_class c ON c.oid = pol.polrelid `+
+		`JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace `+
+		`WHERE n.nspname = $1 AND c.relname = $2 AND pol.polname = $3`, permissiveColumn)
+	err = txn.QueryRow(query, schemaName, tableName, policyName).Scan(
+		&permissive, &command, pq.Array(&roles), &using, &withCheck,
+	)
+	switch {
+	case err == sql.ErrNoRows:
+		log.Printf("[WARN] PostgreSQL policy (%s) on table %s.%s not found in database %s", policyName, schemaName, tableName, database)
+		d.SetId("")
+		return nil
+	case err != nil:
+		return fmt.Errorf("error reading policy: %w", err)
+	}
+
+	as := "PERMISSIVE"
+	if !permissive {
+		as = "RESTRICTIVE"
+	}
+
+	d.Set(policyNameAttr, policyName)
+	d.Set(policyDatabaseAttr, database)
+	d.Set(policySchemaAttr, schemaName)
+	d.Set(policyTableAttr, tableName)
+	d.Set(policyAsAttr, as)
+	d.Set(policyCommandAttr, policyCommands[command])
+	d.Set(policyRolesAttr, stringSliceToSet(roles))
+
+	// PostgreSQL rewrites the expressions, we keep the configured ones until they are changed outside of Terraform.
+	d.Set(policyUsingAttr, resolveStateExpression(d.Get(policyUsingAttr).(string), d.Get(policyUsingDefinitionAttr).(string), using.String))
+	d.Set(policyWithCheckAttr, resolveStateExpression(d.Get(policyWithCheckAttr).(string), d.Get(policyWithCheckDefinitionAttr).(string), withCheck.String))
+	d.Set(policyUsingDefinitionAttr, using.String)
+	d.Set(policyWithCheckDefinitionAttr, withCheck.String)
+
+	d.SetId(generatePolicyID(d, database))
+
+	return nil
+}
+
+func resourcePostgreSQLPolicyUpdate(db *DBConnection, d *schema.ResourceData) error {
+	if err := validatePolicyFeatureSupport(db, d); err != nil {
+		return err
+	}
+
+	database := getDatabase(d, db.client.databaseName)
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if err := setPolicyName(txn, d); err != nil {
+		return err
+	}
+
+	if err := setPolicyClauses(txn, d); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing policy: %w", err)
+	}
+
+	d.SetId(generatePolicyID(d, database))
+
+	return resourcePostgreSQLPolicyReadImpl(db, d)
+}
+
+func setPolicyName(txn *sql.Tx, d *schema.ResourceData) error {
+	if !d.HasChange(policyNameAttr) {
+		return nil
+	}
+
+	oraw, nraw := d.GetChange(policyNameAttr)
+	sql := fmt.Sprintf(
+		"ALTER POLICY %s ON %s RENAME TO %s",
+		pq.QuoteIdentifier(oraw.(string)), getPolicyTableQuotedName(d), pq.QuoteIdentifier(nraw.(string)),
+	)
+	if _, err := txn.Exec(sql); err != nil {
+		return fmt.Errorf("error updating policy NAME: %w", err)
+	}
+
+	return nil
+}
+
+// setPolicyClauses updates the roles and expressions of the policy.
+// ALTER POLICY cannot remove an expression, so the policy is recreated in this case.
+func setPolicyClauses(txn *sql.Tx, d *schema.ResourceData) error {
+	if !d.HasChanges(policyRolesAttr, policyUsingAttr, policyWithCheckAttr) {
+		return nil
+	}
+
+	policyName := pq.QuoteIdentifier(d.Get(policyNameAttr).(string))
+	tableName := getPolicyTableQuotedName(d)
+
+	using := d.Get(policyUsingAttr).(string)
+	withCheck := d.Get(policyWithCheckAttr).(string)
+
+	// Reset the definitions so the new expressions are read back from the database.
+	d.Set(policyUsingDefinitionAttr, "")
+	d.Set(policyWithCheckDefinitionAttr, "")
+
+	if (d.HasChange(policyUsingAttr) && using == "") || (d.HasChange(policyWithCheckAttr) && withCheck == "") {
+		if _, err := txn.Exec(fmt.Sprintf("DROP POLICY %s ON %s", policyName, tableName)); err != nil {
+			return fmt.Errorf("error dropping policy: %w", err)
+		}
+		return createPolicy(txn, d)
+	}
+
+	b := bytes.NewBufferString("ALTER POLICY ")
+	fmt.Fprint(b, policyName, " ON ", tableName)
+
+	if roles := d.Get(policyRolesAttr).(*schema.Set); roles.Len() > 0 {
+		fmt.Fprint(b, " TO ", policyRolesList(roles))
+	} else {
+		fmt.Fprint(b, " TO PUBLIC")
+	}
+	if using != "" {
+		fmt.Fprint(b, " USING (", using, ")")
+	}
+	if withCheck != "" {
+		fmt.Fprint(b, " WITH CHECK (", withCheck, ")")
+	}
+
+	if _, err := txn.Exec(b.String()); err != nil {
+		return fmt.Errorf("error updating policy: %w", err)
+	}
+
+	return nil
+}
+
+func resourcePostgreSQLPolicyDelete(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureRLS) {
+		return fmt.Errorf(
+			"postgresql_policy resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	database := getDatabase(d, db.client.databaseName)
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	sql := fmt.Sprintf(
+		"DROP POLICY IF EXISTS %s ON %s",
+		pq.QuoteIdentifier(d.Get(policyNameAttr).(string)), getPolicyTableQuotedName(d),
+	)
+	if _, err := txn.Exec(sql); err != nil {
+		return fmt.Errorf("error deleting policy: %w", err)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing policy: %w", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func validatePolicyFeatureSupport(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureRLS) {
+		return fmt.Errorf(
+			"postgresql_policy resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+	if d.Get(policyAsAttr).(string) == "RESTRICTIVE" && !db.featureSupported(featureRestrictivePolicy) {
+		return fmt.Errorf(
+			"restrictive policies are not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+	return nil
+}
+
+// policyRolesList returns the quoted list of roles, public is a keyword and must not be quoted.
+func policyRolesList(roles *schema.Set) string {
+	quotedRoles := make([]string, roles.Len())
+	for i, role := range roles.List() {
+		if role.(string) == publicRole {
+			quotedRoles[i] = "PUBLIC"
+			continue
+		}
+		quotedRoles[i] = pq.QuoteIdentifier(role.(string))
+	}
+	return strings.Join(quotedRoles, ", ")
+}
+
+func getPolicyTableQuotedName(d *schema.ResourceData) string {
+	schemaName := "public"
+	if v, ok := d.GetOk(policySchemaAttr); ok {
+		schemaName = v.(string)
+	}
+	return fmt.Sprintf("%s.%s", pq.QuoteIdentifier(schemaName), pq.QuoteIdentifier(d.Get(policyTableAttr).(string)))
+}
+
+func generatePolicyID(d *schema.ResourceData, databaseName string) string {
+	schemaName := "public"
+	if v, ok := d.GetOk(policySchemaAttr); ok {
+		schemaName = v.(string)
+	}
+
+	return strings.Join([]string{
+		getDatabase(d, databaseName),
+		schemaName,
+		d.Get(policyTableAttr).(string),
+		d.Get(policyNameAttr).(string),
+	}, ".")
+}
+
+// getDBPolicyName returns database, schema, table and policy name. If we are importing this
+// resource, they will be parsed from the resource ID (it will return an error if parsing failed)
+// otherwise they will be simply get from the state.
+func getDBPolicyName(d *schema.ResourceData, databaseName string) (string, string, string, string, error) {
+	database := getDatabase(d, databaseName)
+	schemaName := "public"
+	if v, ok := d.GetOk(policySchemaAttr); ok {
+		schemaName = v.(string)
+	}
+	tableName := d.Get(policyTableAttr).(string)
+	policyName := d.Get(policyNameAttr).(string)
+
+	// When importing, we have to parse the ID to find database, schema, table and policy names.
+	if policyName == "" {
+		parsed := strings.Split(d.Id(), ".")
+		if len(parsed) != 4 {
+			return "", "", "", "", fmt.Errorf("policy ID %s has not the expected format 'database.schema.table.policy': %v", d.Id(), parsed)
+		}
+		database = parsed[0]
+		schemaName = parsed[1]
+		tableName = parsed[2]
+		policyName = parsed[3]
+	}
+	return database, schemaName, tableName, policyName, nil
+}
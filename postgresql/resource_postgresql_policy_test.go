@@ -0,0 +1,249 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPostgresqlPolicy_Basic(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	dropTables := createTestTables(t, dbSuffix, []string{"test_policy_table"}, "")
+	defer dropTables()
+
+	dbName, roleName := getTestDBNames(dbSuffix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureRLS)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccPostgresqlPolicyConfig, dbName, dbName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlPolicyExists("postgresql_policy.test"),
+					testAccCheckRowLevelSecurity(dbName, "test_policy_table", true, true),
+					resource.TestCheckResourceAttr("postgresql_row_level_security.test", "force", "true"),
+					resource.TestCheckResourceAttr("postgresql_policy.test", "name", "test_policy"),
+					resource.TestCheckResourceAttr("postgresql_policy.test", "as", "PERMISSIVE"),
+					resource.TestCheckResourceAttr("postgresql_policy.test", "command", "SELECT"),
+					resource.TestCheckResourceAttr("postgresql_policy.test", "roles.#", "1"),
+					resource.TestCheckResourceAttr("postgresql_policy.test", "roles.0", roleName),
+					resource.TestCheckResourceAttr("postgresql_policy.test", "using", "val = current_user"),
+					resource.TestCheckResourceAttr("postgresql_policy.test", "with_check", ""),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccPostgresqlPolicyConfigUpdated, dbName, dbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlPolicyExists("postgresql_policy.test"),
+					testAccCheckRowLevelSecurity(dbName, "test_policy_table", true, false),
+					resource.TestCheckResourceAttr("postgresql_policy.test", "name", "test_policy_renamed"),
+					resource.TestCheckResourceAttr("postgresql_policy.test", "roles.#", "1"),
+					resource.TestCheckResourceAttr("postgresql_policy.test", "roles.0", "public"),
+					resource.TestCheckResourceAttr("postgresql_policy.test", "using", ""),
+					resource.TestCheckResourceAttr("postgresql_policy.test", "with_check", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlPolicy_Restrictive(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	dropTables := createTestTables(t, dbSuffix, []string{"test_policy_table"}, "")
+	defer dropTables()
+
+	dbName, roleName := getTestDBNames(dbSuffix)
+	testConfig := getTestConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureRestrictivePolicy)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccPostgresqlPolicyConfigRestrictive, dbName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlPolicyExists("postgresql_policy.test"),
+					resource.TestCheckResourceAttr("postgresql_policy.test", "as", "RESTRICTIVE"),
+					resource.TestCheckResourceAttr("postgresql_policy.test", "command", "INSERT"),
+					resource.TestCheckResourceAttr("postgresql_policy.test", "with_check", "val <> ''"),
+				),
+			},
+			{
+				// The expression changed outside of Terraform is not hidden by the configured one
+				PreConfig: func() {
+					dbExecute(t, testConfig.connStr(dbName), "ALTER POLICY test_policy ON test_policy_table WITH CHECK (val <> 'changed')")
+				},
+				Config:             fmt.Sprintf(testAccPostgresqlPolicyConfigRestrictive, dbName, roleName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPostgresqlPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "postgresql_policy" {
+			continue
+		}
+
+		exists, err := checkPolicyExists(client, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking policy %s", err)
+		}
+
+		if exists {
+			return fmt.Errorf("Policy still exists after destroy")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckPostgresqlPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Client)
+		exists, err := checkPolicyExists(client, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking policy %s", err)
+		}
+
+		if !exists {
+			return fmt.Errorf("Policy not found")
+		}
+
+		return nil
+	}
+}
+
+func checkPolicyExists(client *Client, policyID string) (bool, error) {
+	parts := strings.Split(policyID, ".")
+	if len(parts) != 4 {
+		return false, fmt.Errorf("unexpected policy ID %s", policyID)
+	}
+
+	txn, err := startTransaction(client, parts[0])
+	if err != nil {
+		return false, err
+	}
+	defer deferredRollback(txn)
+
+	var _rez bool
+	err = txn.QueryRow(
+		"SELECT TRUE FROM pg_catalog.pg_policies WHERE schemaname = $1 AND tablename = $2 AND policyname = $3",
+		parts[1], parts[2], parts[3],
+	).Scan(&_rez)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	return true, nil
+}
+
+func testAccCheckRowLevelSecurity(database, table string, expectedEnabled, expectedForce bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*Client)
+
+		txn, err := startTransaction(client, database)
+		if err != nil {
+			return err
+		}
+		defer deferredRollback(txn)
+
+		var enabled, force bool
+		if err := txn.QueryRow(
+			"SELECT relrowsecurity, relforcerowsecurity FROM pg_catalog.pg_class WHERE oid = $1::regclass",
+			table,
+		).Scan(&enabled, &force); err != nil {
+			return fmt.Errorf("could not read row level security of table %s: %w", table, err)
+		}
+
+		if enabled != expectedEnabled || force != expectedForce {
+			return fmt.Errorf(
+				"wrong row level security on table %s, expected enabled=%t force=%t, got enabled=%t force=%t",
+				table, expectedEnabled, expectedForce, enabled, force,
+			)
+		}
+
+		return nil
+	}
+}
+
+const testAccPostgresqlPolicyConfig = `
+resource "postgresql_row_level_security" "test" {
+  database = "%s"
+  table    = "test_policy_table"
+  force    = true
+}
+
+resource "postgresql_policy" "test" {
+  database = "%s"
+  table    = "test_policy_table"
+  name     = "test_policy"
+  command  = "SELECT"
+  roles    = ["%s"]
+  using    = "val = current_user"
+}
+`
+
+const testAccPostgresqlPolicyConfigUpdated = `
+resource "postgresql_row_level_security" "test" {
+  database = "%s"
+  table    = "test_policy_table"
+}
+
+resource "postgresql_policy" "test" {
+  database = "%s"
+  table    = "test_policy_table"
+  name     = "test_policy_renamed"
+  command  = "SELECT"
+  roles    = ["public"]
+}
+`
+
+const testAccPostgresqlPolicyConfigRestrictive = `
+resource "postgresql_policy" "test" {
+  database   = "%s"
+  table      = "test_policy_table"
+  name       = "test_policy"
+  as         = "RESTRICTIVE"
+  command    = "INSERT"
+  roles      = ["%s"]
+  with_check = "val <> ''"
+}
+`
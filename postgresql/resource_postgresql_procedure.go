@@ -0,0 +1,449 @@
+package postgresql
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/lib/pq"
+)
+
+const (
+	procNameAttr            = "name"
+	procSchemaAttr          = "schema"
+	procBodyAttr            = "body"
+	procArgAttr             = "arg"
+	procLanguageAttr        = "language"
+	procDropCascadeAttr     = "drop_cascade"
+	procDatabaseAttr        = "database"
+	procSecurityDefinerAttr = "security_definer"
+	procSetAttr             = "set"
+)
+
+func resourcePostgreSQLProcedure() *schema.Resource {
+	return &schema.Resource{
+		Create: PGResourceFunc(resourcePostgreSQLProcedureCreate),
+		Read:   PGResourceFunc(resourcePostgreSQLProcedureRead),
+		Update: PGResourceFunc(resourcePostgreSQLProcedureUpdate),
+		Delete: PGResourceFunc(resourcePostgreSQLProcedureDelete),
+		Exists: PGResourceExistsFunc(resourcePostgreSQLProcedureExists),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			procSchemaAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Schema where the procedure is located. If not specified, the provider default schema is used.",
+
+				DiffSuppressFunc: defaultDiffSuppressFunc,
+			},
+			procNameAttr: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the procedure.",
+			},
+			procArgAttr: {
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						funcArgTypeAttr: {
+							Type:        schema.TypeString,
+							Description: "The argument type.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						funcArgNameAttr: {
+							Type:        schema.TypeString,
+							Description: "The argument name. The name may be required for some languages or depending on the argument mode.",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						funcArgModeAttr: {
+							Type:         schema.TypeString,
+							Description:  "The argument mode. One of: IN, OUT, INOUT, or VARIADIC",
+							Optional:     true,
+							Default:      "IN",
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"IN", "OUT", "INOUT", "VARIADIC"}, false),
+
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								if (new == "" && old == "IN") || (new == "IN" && old == "") {
+									return true
+								}
+								return old == new
+							},
+						},
+						funcArgDefaultAttr: {
+							Type:        schema.TypeString,
+							Description: "An expression to be used as default value if the parameter is not specified.",
+							Optional:    true,
+						},
+					},
+				},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Procedure argument definitions.",
+			},
+			procLanguageAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "plpgsql",
+				Description: "Language of the procedure. One of: internal, sql, c, plpgsql",
+
+				DiffSuppressFunc: defaultDiffSuppressFunc,
+			},
+			procBodyAttr: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Body of the procedure.",
+
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return normalizeFunctionBody(new) == old
+				},
+				StateFunc: func(val any) string {
+					return normalizeFunctionBody(val.(string))
+				},
+			},
+			procDropCascadeAttr: {
+				Type:        schema.TypeBool,
+				Description: "Automatically drop objects that depend on the procedure, and in turn all objects that depend on those objects.",
+				Optional:    true,
+				Default:     false,
+			},
+			procSecurityDefinerAttr: {
+				Type:        schema.TypeBool,
+				Description: "If the procedure should execute with the permissions of the procedure owner instead of the permissions of the caller.",
+				Optional:    true,
+				Default:     false,
+			},
+			procSetAttr: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Configuration parameters set when the procedure is called (SET clauses), e.g. search_path",
+			},
+			procDatabaseAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The database where the procedure is located. If not specified, the provider default database is used.",
+
+				DiffSuppressFunc: defaultDiffSuppressFunc,
+			},
+		},
+	}
+}
+
+func resourcePostgreSQLProcedureCreate(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureProcedure) {
+		return fmt.Errorf(
+			"postgresql_procedure resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	if err := createProcedure(db, d, false); err != nil {
+		return err
+	}
+
+	return resourcePostgreSQLProcedureReadImpl(db, d)
+}
+
+func resourcePostgreSQLProcedureExists(db *DBConnection, d *schema.ResourceData) (bool, error) {
+	if !db.featureSupported(featureProcedure) {
+		return false, fmt.Errorf(
+			"postgresql_procedure resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	databaseName, procedureSignature, err := expandFunctionID(d.Id(), d, db)
+	if err != nil {
+		return false, err
+	}
+
+	txn, err := startTransaction(db.client, databaseName)
+	if err != nil {
+		return false, err
+	}
+	defer deferredRollback(txn)
+
+	var procedureExists bool
+	if err := txn.QueryRow(
+		"SELECT EXISTS (SELECT 1 FROM pg_proc WHERE oid = to_regprocedure($1) AND prokind = 'p')",
+		procedureSignature,
+	).Scan(&procedureExists); err != nil {
+		return false, err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return false, err
+	}
+
+	return procedureExists, nil
+}
+
+func resourcePostgreSQLProcedureRead(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureProcedure) {
+		return fmt.Errorf(
+			"postgresql_procedure resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	return resourcePostgreSQLProcedureReadImpl(db, d)
+}
+
+func resourcePostgreSQLProcedureReadImpl(db *DBConnection, d *schema.ResourceData) error {
+	procedureId := d.Id()
+
+	if procedureId == "" {
+		// Generate during creation
+		generatedProcedureId, err := generateProcedureID(db, d)
+		if err != nil {
+			return err
+		}
+		procedureId = generatedProcedureId
+	}
+
+	databaseName, procedureSignature, err := expandFunctionID(procedureId, d, db)
+	if err != nil {
+		return err
+	}
+
+	var procDefinition string
+	var procConfig pq.ByteaArray
+
+	query := `SELECT pg_get_functiondef(p.oid), p.proconfig ` +
+		`FROM pg_proc p ` +
+		`WHERE p.oid = to_regprocedure($1) AND p.prokind = 'p'`
+
+	txn, err := startTransaction(db.client, databaseName)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	err = txn.QueryRow(query, procedureSignature).Scan(&procDefinition, &procConfig)
+	switch {
+	case err == sql.ErrNoRows:
+		log.Printf("[WARN] PostgreSQL procedure: %s", procedureId)
+		d.SetId("")
+		return nil
+	case err != nil:
+		return fmt.Errorf("error reading procedure: %w", err)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return err
+	}
+
+	var pgProcedure PGProcedure
+
+	if err := pgProcedure.Parse(procDefinition); err != nil {
+		return err
+	}
+
+	var args []map[string]any
+
+	for _, a := range pgProcedure.Args {
+		args = append(args, map[string]any{
+			funcArgTypeAttr:    a.Type,
+			funcArgNameAttr:    a.Name,
+			funcArgModeAttr:    a.Mode,
+			funcArgDefaultAttr: a.Default,
+		})
+	}
+
+	d.Set(procDatabaseAttr, databaseName)
+	d.Set(procNameAttr, pgProcedure.Name)
+	d.Set(procSchemaAttr, pgProcedure.Schema)
+	d.Set(procLanguageAttr, pgProcedure.Language)
+	d.Set(procBodyAttr, pgProcedure.Body)
+	d.Set(procSecurityDefinerAttr, pgProcedure.SecurityDefiner)
+	d.Set(procSetAttr, readParameters(procConfig))
+	d.Set(procArgAttr, args)
+
+	d.SetId(procedureId)
+
+	return nil
+}
+
+func resourcePostgreSQLProcedureDelete(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureProcedure) {
+		return fmt.Errorf(
+			"postgresql_procedure resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	databaseName, procedureSignature, err := expandFunctionID(d.Id(), d, db)
+	if err != nil {
+		return err
+	}
+
+	dropMode := "RESTRICT"
+	if v, ok := d.GetOk(procDropCascadeAttr); ok && v.(bool) {
+		dropMode = "CASCADE"
+	}
+
+	sql := fmt.Sprintf("DROP PROCEDURE IF EXISTS %s %s", procedureSignature, dropMode)
+
+	txn, err := startTransaction(db.client, databaseName)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if _, err := txn.Exec(sql); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePostgreSQLProcedureUpdate(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureProcedure) {
+		return fmt.Errorf(
+			"postgresql_procedure resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	if err := createProcedure(db, d, true); err != nil {
+		return err
+	}
+
+	return resourcePostgreSQLProcedureReadImpl(db, d)
+}
+
+func createProcedure(db *DBConnection, d *schema.ResourceData, replace bool) error {
+	var pgProcedure PGProcedure
+	if err := pgProcedure.FromResourceData(d); err != nil {
+		return err
+	}
+
+	txn, err := startTransaction(db.client, d.Get(procDatabaseAttr).(string))
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if _, err := txn.Exec(createProcedureQuery(pgProcedure, replace)); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func createProcedureQuery(pgProcedure PGProcedure, replace bool) string {
+	b := bytes.NewBufferString("CREATE ")
+
+	if replace {
+		b.WriteString("OR REPLACE ")
+	}
+
+	b.WriteString("PROCEDURE ")
+
+	fmt.Fprint(b, pq.QuoteIdentifier(pgProcedure.Schema), ".")
+
+	fmt.Fprint(b, pq.QuoteIdentifier(pgProcedure.Name), " (")
+
+	for i, arg := range pgProcedure.Args {
+		if i > 0 {
+			b.WriteRune(',')
+		}
+
+		b.WriteString("\n    ")
+
+		if arg.Mode != "" {
+			fmt.Fprint(b, arg.Mode, " ")
+		}
+
+		if arg.Name != "" {
+			fmt.Fprint(b, arg.Name, " ")
+		}
+
+		b.WriteString(arg.Type)
+
+		if arg.Default != "" {
+			fmt.Fprint(b, " DEFAULT ", arg.Default)
+		}
+	}
+
+	if len(pgProcedure.Args) > 0 {
+		b.WriteRune('\n')
+	}
+
+	b.WriteString(")")
+
+	fmt.Fprint(b, "\nLANGUAGE ", pgProcedure.Language)
+	if pgProcedure.SecurityDefiner {
+		fmt.Fprint(b, "\nSECURITY DEFINER")
+	}
+
+	names := make([]string, 0, len(pgProcedure.Parameters))
+	for name := range pgProcedure.Parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprint(b, "\nSET ", quoteParameterName(name), " TO ", formatParameterValue(name, pgProcedure.Parameters[name]))
+	}
+
+	fmt.Fprint(b, "\nAS $procedure$", pgProcedure.Body, "$procedure$;")
+
+	return b.String()
+}
+
+// generateProcedureID returns the ID database.schema.name(argtypes).
+// Unlike functions, the OUT arguments are part of the procedure signature.
+func generateProcedureID(db *DBConnection, d *schema.ResourceData) (string, error) {
+
+	b := bytes.NewBufferString("")
+
+	if dbAttr, ok := d.GetOk(procDatabaseAttr); ok {
+		fmt.Fprint(b, dbAttr.(string), ".")
+	} else {
+		fmt.Fprint(b, db.client.databaseName, ".")
+	}
+
+	var pgProcedure PGProcedure
+	if err := pgProcedure.FromResourceData(d); err != nil {
+		return "", err
+	}
+
+	fmt.Fprint(b, pgProcedure.Schema, ".", pgProcedure.Name, "(")
+
+	for i, arg := range pgProcedure.Args {
+		if i > 0 {
+			b.WriteRune(',')
+		}
+		b.WriteString(arg.Type)
+	}
+
+	b.WriteRune(')')
+
+	return b.String(), nil
+}
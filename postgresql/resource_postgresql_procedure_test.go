@@ -0,0 +1,200 @@
+package postgresql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPostgresqlProcedure_Basic(t *testing.T) {
+	config := `
+resource "postgresql_procedure" "basic_procedure" {
+    name = "basic_procedure"
+    arg {
+        name = "i"
+        type = "integer"
+    }
+    arg {
+        name = "result"
+        type = "integer"
+        mode = "INOUT"
+    }
+    language = "plpgsql"
+    body = <<-EOF
+        BEGIN
+            result := i + 1;
+        END;
+    EOF
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureProcedure)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlProcedureDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlProcedureExists("postgresql_procedure.basic_procedure"),
+					resource.TestCheckResourceAttr(
+						"postgresql_procedure.basic_procedure", "name", "basic_procedure"),
+					resource.TestCheckResourceAttr(
+						"postgresql_procedure.basic_procedure", "schema", "public"),
+					resource.TestCheckResourceAttr(
+						"postgresql_procedure.basic_procedure", "language", "plpgsql"),
+					resource.TestCheckResourceAttr(
+						"postgresql_procedure.basic_procedure", "arg.1.mode", "INOUT"),
+				),
+			},
+			{
+				ResourceName:            "postgresql_procedure.basic_procedure",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{procDropCascadeAttr},
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlProcedure_Update(t *testing.T) {
+	configCreate := `
+resource "postgresql_procedure" "procedure" {
+    name = "update_procedure"
+    arg {
+        name = "i"
+        type = "integer"
+    }
+    body = <<-EOF
+        BEGIN
+            RAISE NOTICE 'value: %', i;
+        END;
+    EOF
+}
+`
+
+	configUpdate := `
+resource "postgresql_procedure" "procedure" {
+    name = "update_procedure"
+    arg {
+        name = "i"
+        type = "integer"
+    }
+    security_definer = true
+    set = {
+        search_path = "public, pg_temp"
+        work_mem    = "64MB"
+    }
+    body = <<-EOF
+        BEGIN
+            RAISE NOTICE 'updated value: %', i;
+        END;
+    EOF
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureProcedure)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlProcedureDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: configCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlProcedureExists("postgresql_procedure.procedure"),
+					resource.TestCheckResourceAttr(
+						"postgresql_procedure.procedure", "security_definer", "false"),
+					resource.TestCheckResourceAttr(
+						"postgresql_procedure.procedure", "set.%", "0"),
+				),
+			},
+			{
+				Config: configUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlProcedureExists("postgresql_procedure.procedure"),
+					resource.TestCheckResourceAttr(
+						"postgresql_procedure.procedure", "security_definer", "true"),
+					resource.TestCheckResourceAttr(
+						"postgresql_procedure.procedure", "set.search_path", "public, pg_temp"),
+					resource.TestCheckResourceAttr(
+						"postgresql_procedure.procedure", "set.work_mem", "64MB"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPostgresqlProcedureExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		exists, err := checkProcedureExists(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking procedure %s", err)
+		}
+
+		if !exists {
+			return fmt.Errorf("Procedure not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPostgresqlProcedureDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "postgresql_procedure" {
+			continue
+		}
+
+		exists, err := checkProcedureExists(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking procedure %s", err)
+		}
+
+		if exists {
+			return fmt.Errorf("Procedure still exists after destroy")
+		}
+	}
+
+	return nil
+}
+
+func checkProcedureExists(procedureID string) (bool, error) {
+	client := testAccProvider.Meta().(*Client)
+
+	databaseName, signature, err := expandFunctionID(procedureID, nil, nil)
+	if err != nil {
+		return false, err
+	}
+
+	txn, err := startTransaction(client, databaseName)
+	if err != nil {
+		return false, err
+	}
+	defer deferredRollback(txn)
+
+	var exists bool
+	if err := txn.QueryRow(
+		"SELECT EXISTS (SELECT 1 FROM pg_proc WHERE oid = to_regprocedure($1) AND prokind = 'p')", signature,
+	).Scan(&exists); err != nil {
+		return false, fmt.Errorf("error reading info about procedure: %w", err)
+	}
+
+	return exists, nil
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,6 +18,12 @@ const (
 	pubDatabaseAttr                = "database"
 	pubAllTablesAttr               = "all_tables"
 	pubTablesAttr                  = "tables"
+	pubTableAttr                   = "table"
+	pubTableNameAttr               = "name"
+	pubTableColumnsAttr            = "columns"
+	pubTableRowFilterAttr          = "row_filter"
+	pubRowFilterDefinitionsAttr    = "row_filter_definitions"
+	pubSchemasAttr                 = "schemas"
 	pubDropCascadeAttr             = "drop_cascade"
 	pubPublishAttr                 = "publish_param"
 	pubPublishViaPartitionRootAttr = "publish_via_partition_root_param"
@@ -62,6 +69,49 @@ func resourcePostgreSQLPublication() *schema.Resource {
 				ForceNew:      false,
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				Description:   "Sets the tables list to publish",
+				ConflictsWith: []string{pubAllTablesAttr, pubTableAttr},
+			},
+			pubTableAttr: {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Set:           publicationTableHash,
+				Description:   "Sets the tables to publish with their column lists and row filters",
+				ConflictsWith: []string{pubAllTablesAttr, pubTablesAttr},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						pubTableNameAttr: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The name of the table to publish (`<schema_name>.<table_name>`)",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						pubTableColumnsAttr: {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The columns to publish. Defaults to all the columns",
+						},
+						pubTableRowFilterAttr: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The expression filtering the rows to publish",
+						},
+					},
+				},
+			},
+			pubRowFilterDefinitionsAttr: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The row filters of the tables as stored by PostgreSQL",
+			},
+			pubSchemasAttr: {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				Description:   "Sets the schemas in which all the tables are published",
 				ConflictsWith: []string{pubAllTablesAttr},
 			},
 			pubAllTablesAttr: {
@@ -115,10 +165,18 @@ func resourcePostgreSQLPublicationUpdate(db *DBConnection, d *schema.ResourceDat
 		return fmt.Errorf("could not update publication owner: %w", err)
 	}
 
+	if err := validatePublicationTables(db, d); err != nil {
+		return err
+	}
+
 	if err := setPubTables(txn, d); err != nil {
 		return fmt.Errorf("could not update publication tables: %w", err)
 	}
 
+	if err := setPubSchemas(txn, d); err != nil {
+		return fmt.Errorf("could not update publication schemas: %w", err)
+	}
+
 	if err := setPubParams(txn, d, db.featureSupported(featurePublishViaRoot)); err != nil {
 		return fmt.Errorf("could not update publication tables: %w", err)
 	}
@@ -166,30 +224,37 @@ func setPubOwner(txn *sql.Tx, d *schema.ResourceData) error {
 }
 
 func setPubTables(txn *sql.Tx, d *schema.ResourceData) error {
-	if !d.HasChange(pubTablesAttr) {
+	if !d.HasChange(pubTablesAttr) && !d.HasChange(pubTableAttr) {
 		return nil
 	}
 
 	var queries []string
 	pubName := d.Get(pubNameAttr).(string)
 
-	oraw, nraw := d.GetChange(pubTablesAttr)
-	oldList := oraw.(*schema.Set).List()
-	newList := nraw.(*schema.Set).List()
-	if elem, ok := isUniqueArr(newList); !ok {
+	oldTables, nraw := d.GetChange(pubTablesAttr)
+	if elem, ok := isUniqueArr(nraw.(*schema.Set).List()); !ok {
 		return fmt.Errorf("'%s' is duplicated for attribute `%s`", elem.(string), pubTablesAttr)
 	}
-	dropped := arrayDifference(oldList, newList)
-	added := arrayDifference(newList, oldList)
+	oldTableBlocks, newTableBlocks := d.GetChange(pubTableAttr)
 
-	for _, p := range added {
-		query := fmt.Sprintf("ALTER PUBLICATION %s ADD TABLE %s", pubName, quoteTableName(p.(string)))
-		queries = append(queries, query)
+	oldDefs := getPublicationTableDefinitions(oldTables.(*schema.Set), oldTableBlocks.(*schema.Set))
+	newDefs := getPublicationTableDefinitions(nraw.(*schema.Set), newTableBlocks.(*schema.Set))
+
+	definitions := d.Get(pubRowFilterDefinitionsAttr).(map[string]any)
+
+	// A table whose column list or row filter changed is dropped then added back in the same transaction.
+	for name, oldDef := range oldDefs {
+		if newDef, ok := newDefs[name]; !ok || newDef != oldDef {
+			queries = append(queries, fmt.Sprintf("ALTER PUBLICATION %s DROP TABLE %s", pubName, quoteTableName(name)))
+			delete(definitions, name)
+		}
 	}
 
-	for _, p := range dropped {
-		query := fmt.Sprintf("ALTER PUBLICATION %s DROP TABLE %s", pubName, quoteTableName(p.(string)))
-		queries = append(queries, query)
+	for name, newDef := range newDefs {
+		if oldDef, ok := oldDefs[name]; !ok || newDef != oldDef {
+			queries = append(queries, fmt.Sprintf("ALTER PUBLICATION %s ADD TABLE %s", pubName, newDef))
+			delete(definitions, name)
+		}
 	}
 
 	for _, query := range queries {
@@ -197,6 +262,35 @@ func setPubTables(txn *sql.Tx, d *schema.ResourceData) error {
 			return fmt.Errorf("could not alter publication table: %w", err)
 		}
 	}
+
+	// Reset the definitions of the changed tables so their new row filters are read back from the database.
+	d.Set(pubRowFilterDefinitionsAttr, definitions)
+	return nil
+}
+
+func setPubSchemas(txn *sql.Tx, d *schema.ResourceData) error {
+	if !d.HasChange(pubSchemasAttr) {
+		return nil
+	}
+
+	pubName := d.Get(pubNameAttr).(string)
+	oraw, nraw := d.GetChange(pubSchemasAttr)
+	oldList := oraw.(*schema.Set).List()
+	newList := nraw.(*schema.Set).List()
+
+	var queries []string
+	for _, s := range arrayDifference(oldList, newList) {
+		queries = append(queries, fmt.Sprintf("ALTER PUBLICATION %s DROP TABLES IN SCHEMA %s", pubName, pq.QuoteIdentifier(s.(string))))
+	}
+	for _, s := range arrayDifference(newList, oldList) {
+		queries = append(queries, fmt.Sprintf("ALTER PUBLICATION %s ADD TABLES IN SCHEMA %s", pubName, pq.QuoteIdentifier(s.(string))))
+	}
+
+	for _, query := range queries {
+		if _, err := txn.Exec(query); err != nil {
+			return fmt.Errorf("could not alter publication schema: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -226,6 +320,9 @@ func resourcePostgreSQLPublicationCreate(db *DBConnection, d *schema.ResourceDat
 
 	name := d.Get(pubNameAttr).(string)
 	databaseName := getDatabaseForPublication(d, db.client.databaseName)
+	if err := validatePublicationTables(db, d); err != nil {
+		return err
+	}
 	tables, err := getTablesForPublication(d)
 	if err != nil {
 		return fmt.Errorf("could not get tables for publication: %w", err)
@@ -354,30 +451,22 @@ func resourcePostgreSQLPublicationReadImpl(db *DBConnection, d *schema.ResourceD
 		return fmt.Errorf("error reading publication info: %w", err)
 	}
 
-	query = `SELECT CONCAT(schemaname,'.',tablename) as fulltablename ` +
-		`FROM pg_catalog.pg_publication_tables ` +
-		`WHERE pubname = $1`
-
-	rows, err := txn.Query(query, pqQuoteLiteral(PublicationName))
-	if err != nil {
-		return fmt.Errorf("could not get publication tables: %w", err)
-	}
-	defer func() {
-		if err := rows.Close(); err != nil {
-			log.Printf("error closing rows: %v", err)
+	var tableBlocks []map[string]any
+	var rowFilterDefinitions map[string]string
+	var schemas []string
+	if puballtables || !db.featureSupported(featurePubTablesInSchema) {
+		if tables, err = readPublicationAllTables(txn, PublicationName); err != nil {
+			return err
 		}
-	}()
-
-	for rows.Next() {
-		var table string
-		err := rows.Scan(&table)
-		if err != nil {
-			return fmt.Errorf("could not get tables: %w", err)
+	} else {
+		// pg_publication_tables also lists the tables of the published schemas,
+		// so the tables explicitly added to the publication are read from pg_publication_rel.
+		if tables, tableBlocks, rowFilterDefinitions, err = readPublicationTables(txn, PublicationName, d); err != nil {
+			return err
+		}
+		if schemas, err = readPublicationSchemas(txn, PublicationName); err != nil {
+			return err
 		}
-		tables = append(tables, table)
-	}
-	if err := rows.Err(); err != nil {
-		return fmt.Errorf("got rows.Err: %w", err)
 	}
 
 	if pubinsert {
@@ -398,6 +487,9 @@ func resourcePostgreSQLPublicationReadImpl(db *DBConnection, d *schema.ResourceD
 	d.Set(pubDatabaseAttr, database)
 	d.Set(pubOwnerAttr, pubowner)
 	d.Set(pubTablesAttr, tables)
+	d.Set(pubTableAttr, tableBlocks)
+	d.Set(pubRowFilterDefinitionsAttr, rowFilterDefinitions)
+	d.Set(pubSchemasAttr, schemas)
 	d.Set(pubAllTablesAttr, puballtables)
 	d.Set(pubPublishAttr, publishParams)
 	if sliceContainsStr(columns, "pubviaroot") {
@@ -406,6 +498,139 @@ func resourcePostgreSQLPublicationReadImpl(db *DBConnection, d *schema.ResourceD
 	return nil
 }
 
+// readPublicationAllTables returns all the tables published by the publication.
+func readPublicationAllTables(txn *sql.Tx, pubName string) ([]string, error) {
+	query := `SELECT CONCAT(schemaname,'.',tablename) as fulltablename ` +
+		`FROM pg_catalog.pg_publication_tables ` +
+		`WHERE pubname = $1`
+
+	rows, err := txn.Query(query, pqQuoteLiteral(pubName))
+	if err != nil {
+		return nil, fmt.Errorf("could not get publication tables: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("error closing rows: %v", err)
+		}
+	}()
+
+	var tables []string
+	for rows.Next() {
+		var table string
+		err := rows.Scan(&table)
+		if err != nil {
+			return nil, fmt.Errorf("could not get tables: %w", err)
+		}
+		tables = append(tables, table)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("got rows.Err: %w", err)
+	}
+
+	return tables, nil
+}
+
+// readPublicationTables returns the tables explicitly added to the publication and their row filters as stored by Postgres.
+// The table blocks are returned if they are used in the state or if a table has a column list or a row filter (e.g.: on import).
+func readPublicationTables(txn *sql.Tx, pubName string, d *schema.ResourceData) ([]string, []map[string]any, map[string]string, error) {
+	query := `SELECT CONCAT(n.nspname, '.', c.relname), pg_catalog.pg_get_expr(pr.prqual, pr.prrelid), ` +
+		`(SELECT pg_catalog.array_agg(a.attname ORDER BY a.attnum) FROM pg_catalog.pg_attribute a ` +
+		`WHERE a.attrelid = pr.prrelid AND a.attnum = ANY(pr.prattrs)) ` +
+		`FROM pg_catalog.pg_publication_rel pr ` +
+		`JOIN pg_catalog.pg_publication p ON p.oid = pr.prpubid ` +
+		`JOIN pg_catalog.pg_class c ON c.oid = pr.prrelid ` +
+		`JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace ` +
+		`WHERE p.pubname = $1 ORDER BY 1`
+
+	rows, err := txn.Query(query, pqQuoteLiteral(pubName))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not get publication tables: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("error closing rows: %v", err)
+		}
+	}()
+
+	stateRowFilters := map[string]string{}
+	for _, raw := range d.Get(pubTableAttr).(*schema.Set).List() {
+		table := raw.(map[string]any)
+		stateRowFilters[table[pubTableNameAttr].(string)] = table[pubTableRowFilterAttr].(string)
+	}
+	stateDefinitions := d.Get(pubRowFilterDefinitionsAttr).(map[string]any)
+
+	useBlocks := len(stateRowFilters) > 0
+	var tables []string
+	var tableBlocks []map[string]any
+	definitions := map[string]string{}
+	for rows.Next() {
+		var table string
+		var rowFilter sql.NullString
+		var columns pq.StringArray
+		if err := rows.Scan(&table, &rowFilter, &columns); err != nil {
+			return nil, nil, nil, fmt.Errorf("could not get tables: %w", err)
+		}
+		if rowFilter.Valid || len(columns) > 0 {
+			useBlocks = true
+		}
+
+		// Postgres stores the row filter as a parsed expression, so the row filter of the state is kept
+		// until the one of the publication is changed outside of Terraform.
+		catalogRowFilter := normalizeExpression(rowFilter.String)
+		stateDefinition, _ := stateDefinitions[table].(string)
+		if rowFilter.Valid {
+			definitions[table] = catalogRowFilter
+		}
+
+		tables = append(tables, table)
+		tableBlocks = append(tableBlocks, map[string]any{
+			pubTableNameAttr:      table,
+			pubTableColumnsAttr:   []string(columns),
+			pubTableRowFilterAttr: resolveStateExpression(stateRowFilters[table], stateDefinition, catalogRowFilter),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, nil, fmt.Errorf("got rows.Err: %w", err)
+	}
+
+	if !useBlocks {
+		tableBlocks = nil
+	}
+	return tables, tableBlocks, definitions, nil
+}
+
+// readPublicationSchemas returns the schemas in which all the tables are published.
+func readPublicationSchemas(txn *sql.Tx, pubName string) ([]string, error) {
+	query := `SELECT n.nspname FROM pg_catalog.pg_publication_namespace pn ` +
+		`JOIN pg_catalog.pg_publication p ON p.oid = pn.pnpubid ` +
+		`JOIN pg_catalog.pg_namespace n ON n.oid = pn.pnnspid ` +
+		`WHERE p.pubname = $1`
+
+	rows, err := txn.Query(query, pqQuoteLiteral(pubName))
+	if err != nil {
+		return nil, fmt.Errorf("could not get publication schemas: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("error closing rows: %v", err)
+		}
+	}()
+
+	var schemas []string
+	for rows.Next() {
+		var schemaName string
+		if err := rows.Scan(&schemaName); err != nil {
+			return nil, fmt.Errorf("could not get schemas: %w", err)
+		}
+		schemas = append(schemas, schemaName)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("got rows.Err: %w", err)
+	}
+
+	return schemas, nil
+}
+
 func resourcePostgreSQLPublicationDelete(db *DBConnection, d *schema.ResourceData) error {
 	if !db.featureSupported(featurePublication) {
 		return fmt.Errorf(
@@ -458,21 +683,119 @@ func getTablesForPublication(d *schema.ResourceData) (string, error) {
 			tablesString = "FOR ALL TABLES"
 		}
 	}
+
 	if ok {
 		tables := setTables.(*schema.Set).List()
-		var tlist []string
 		if elem, ok := isUniqueArr(tables); !ok {
 			return tablesString, fmt.Errorf("'%s' is duplicated for attribute `%s`", elem.(string), pubTablesAttr)
 		}
-		for _, t := range tables {
-			tlist = append(tlist, quoteTableName(t.(string)))
+	}
+
+	var objects []string
+	tableDefs := getPublicationTableDefinitions(d.Get(pubTablesAttr).(*schema.Set), d.Get(pubTableAttr).(*schema.Set))
+	if len(tableDefs) > 0 {
+		var tlist []string
+		for _, def := range tableDefs {
+			tlist = append(tlist, def)
 		}
-		tablesString = fmt.Sprintf("FOR TABLE %s", strings.Join(tlist, ", "))
+		sort.Strings(tlist)
+		objects = append(objects, fmt.Sprintf("TABLE %s", strings.Join(tlist, ", ")))
+	}
+
+	if v, ok := d.GetOk(pubSchemasAttr); ok {
+		var slist []string
+		for _, s := range v.(*schema.Set).List() {
+			slist = append(slist, pq.QuoteIdentifier(s.(string)))
+		}
+		sort.Strings(slist)
+		objects = append(objects, fmt.Sprintf("TABLES IN SCHEMA %s", strings.Join(slist, ", ")))
+	}
+
+	if len(objects) > 0 {
+		tablesString = fmt.Sprintf("FOR %s", strings.Join(objects, ", "))
 	}
 
 	return tablesString, nil
 }
 
+// getPublicationTableDefinitions returns the SQL definitions (name, column list and row filter) of the published tables
+// indexed by table name. The table blocks are used if set, the tables list otherwise.
+func getPublicationTableDefinitions(tables *schema.Set, tableBlocks *schema.Set) map[string]string {
+	defs := map[string]string{}
+
+	if tableBlocks.Len() == 0 {
+		for _, t := range tables.List() {
+			defs[t.(string)] = quoteTableName(t.(string))
+		}
+		return defs
+	}
+
+	for _, raw := range tableBlocks.List() {
+		table := raw.(map[string]any)
+		name := table[pubTableNameAttr].(string)
+
+		def := quoteTableName(name)
+		if columns := table[pubTableColumnsAttr].(*schema.Set).List(); len(columns) > 0 {
+			var clist []string
+			for _, c := range columns {
+				clist = append(clist, pq.QuoteIdentifier(c.(string)))
+			}
+			sort.Strings(clist)
+			def += fmt.Sprintf(" (%s)", strings.Join(clist, ", "))
+		}
+		if rowFilter := normalizeExpression(table[pubTableRowFilterAttr].(string)); rowFilter != "" {
+			def += fmt.Sprintf(" WHERE (%s)", rowFilter)
+		}
+		defs[name] = def
+	}
+
+	return defs
+}
+
+// validatePublicationTables checks that the Postgres version supports the schemas, column lists and row filters.
+func validatePublicationTables(db *DBConnection, d *schema.ResourceData) error {
+	if v, ok := d.GetOk(pubSchemasAttr); ok && v.(*schema.Set).Len() > 0 && !db.featureSupported(featurePubTablesInSchema) {
+		return fmt.Errorf("%s attribute is supported only for postgres version 15 and above", pubSchemasAttr)
+	}
+
+	for _, raw := range d.Get(pubTableAttr).(*schema.Set).List() {
+		table := raw.(map[string]any)
+		if table[pubTableColumnsAttr].(*schema.Set).Len() > 0 && !db.featureSupported(featurePubColumnList) {
+			return fmt.Errorf("%s attribute of %s is supported only for postgres version 15 and above", pubTableColumnsAttr, pubTableAttr)
+		}
+		if table[pubTableRowFilterAttr].(string) != "" && !db.featureSupported(featurePubRowFilter) {
+			return fmt.Errorf("%s attribute of %s is supported only for postgres version 15 and above", pubTableRowFilterAttr, pubTableAttr)
+		}
+	}
+
+	return nil
+}
+
+func publicationTableHash(v any) int {
+	table := v.(map[string]any)
+
+	var columns []string
+	switch raw := table[pubTableColumnsAttr].(type) {
+	case *schema.Set:
+		for _, c := range raw.List() {
+			columns = append(columns, c.(string))
+		}
+	case []any:
+		for _, c := range raw {
+			columns = append(columns, c.(string))
+		}
+	case []string:
+		columns = append(columns, raw...)
+	}
+	sort.Strings(columns)
+
+	rowFilter, _ := table[pubTableRowFilterAttr].(string)
+
+	return schema.HashString(fmt.Sprintf(
+		"%s-%s-%s", table[pubTableNameAttr].(string), strings.Join(columns, ","), normalizeExpression(rowFilter),
+	))
+}
+
 func validatedPublicationPublishParams(paramList []any) ([]string, error) {
 	var attrs []string
 	if elem, ok := isUniqueArr(paramList); !ok {
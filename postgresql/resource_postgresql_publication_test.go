@@ -817,3 +817,135 @@ resource "postgresql_publication" "test" {
 		},
 	})
 }
+
+func TestAccPostgresqlPublication_TableBlocksAndSchemas(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+	createTestSchemas(t, dbSuffix, []string{"test_schema2"}, "")
+	testTables := []string{"test_schema.test_table_1", "test_schema.test_table_2", "test_schema2.test_table_3"}
+	createTestTables(t, dbSuffix, testTables, "")
+
+	dbName, _ := getTestDBNames(dbSuffix)
+	testConfig := getTestConfig(t)
+
+	testAccPostgresqlPublicationTableBlocksConfig := fmt.Sprintf(`
+resource "postgresql_publication" "test" {
+	name     = "publication"
+	database = "%s"
+	schemas  = ["test_schema2"]
+
+	table {
+		name       = "test_schema.test_table_1"
+		columns    = ["val", "test_column_one"]
+		row_filter = "val IS NOT NULL"
+	}
+
+	table {
+		name = "test_schema.test_table_2"
+	}
+}
+`, dbName)
+
+	testAccPostgresqlPublicationTableBlocksUpdateConfig := fmt.Sprintf(`
+resource "postgresql_publication" "test" {
+	name     = "publication"
+	database = "%s"
+
+	table {
+		name       = "test_schema.test_table_1"
+		row_filter = "(test_column_two IS NULL)"
+	}
+}
+`, dbName)
+
+	testAccPostgresqlPublicationTableBlocksLiteralConfig := fmt.Sprintf(`
+resource "postgresql_publication" "test" {
+	name     = "publication"
+	database = "%s"
+
+	table {
+		name       = "test_schema.test_table_1"
+		row_filter = "val = 'active'"
+	}
+}
+`, dbName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featurePubTablesInSchema)
+			testCheckCompatibleVersion(t, featurePubRowFilter)
+			testCheckCompatibleVersion(t, featurePubColumnList)
+			testSuperuserPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlPublicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPostgresqlPublicationTableBlocksConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlPublicationExists("postgresql_publication.test"),
+					resource.TestCheckResourceAttr("postgresql_publication.test", fmt.Sprintf("%s.#", pubSchemasAttr), "1"),
+					resource.TestCheckTypeSetElemAttr("postgresql_publication.test", fmt.Sprintf("%s.*", pubSchemasAttr), "test_schema2"),
+					resource.TestCheckResourceAttr("postgresql_publication.test", fmt.Sprintf("%s.#", pubTablesAttr), "2"),
+					resource.TestCheckResourceAttr("postgresql_publication.test", fmt.Sprintf("%s.#", pubTableAttr), "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("postgresql_publication.test", fmt.Sprintf("%s.*", pubTableAttr), map[string]string{
+						"name":       "test_schema.test_table_1",
+						"columns.#":  "2",
+						"row_filter": "val IS NOT NULL",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("postgresql_publication.test", fmt.Sprintf("%s.*", pubTableAttr), map[string]string{
+						"name":       "test_schema.test_table_2",
+						"columns.#":  "0",
+						"row_filter": "",
+					}),
+				),
+			},
+			{
+				Config: testAccPostgresqlPublicationTableBlocksUpdateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlPublicationExists("postgresql_publication.test"),
+					resource.TestCheckResourceAttr("postgresql_publication.test", fmt.Sprintf("%s.#", pubSchemasAttr), "0"),
+					resource.TestCheckResourceAttr("postgresql_publication.test", fmt.Sprintf("%s.#", pubTablesAttr), "1"),
+					resource.TestCheckResourceAttr("postgresql_publication.test", fmt.Sprintf("%s.#", pubTableAttr), "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("postgresql_publication.test", fmt.Sprintf("%s.*", pubTableAttr), map[string]string{
+						"name":       "test_schema.test_table_1",
+						"columns.#":  "0",
+						"row_filter": "test_column_two IS NULL",
+					}),
+				),
+			},
+			{
+				ResourceName:      "postgresql_publication.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPostgresqlPublicationTableBlocksLiteralConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlPublicationExists("postgresql_publication.test"),
+					// Postgres returns the filter as `(val = 'active'::text)`, the configured one is kept
+					resource.TestCheckTypeSetElemNestedAttrs("postgresql_publication.test", fmt.Sprintf("%s.*", pubTableAttr), map[string]string{
+						"name":       "test_schema.test_table_1",
+						"row_filter": "val = 'active'",
+					}),
+				),
+			},
+			{
+				Config:   testAccPostgresqlPublicationTableBlocksLiteralConfig,
+				PlanOnly: true,
+			},
+			{
+				// The row filter changed outside of Terraform is not hidden by the configured one
+				PreConfig: func() {
+					dbExecute(t, testConfig.connStr(dbName), "ALTER PUBLICATION publication SET TABLE test_schema.test_table_1 WHERE (val = 'inactive')")
+				},
+				Config:             testAccPostgresqlPublicationTableBlocksLiteralConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -38,6 +38,50 @@ func resourcePostgreSQLReplicationSlot() *schema.Resource {
 				ForceNew:    true,
 				Description: "Sets the output plugin to use",
 			},
+			"two_phase": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Enables the decoding of prepared transactions",
+			},
+			"failover": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Enables the synchronization of the slot to the standbys",
+			},
+			"restart_lsn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The address of the oldest WAL which still might be required by the consumer of this slot",
+			},
+			"confirmed_flush_lsn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The address up to which the consumer of this slot has confirmed receiving data",
+			},
+			"wal_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The availability of the WAL files claimed by this slot (reserved, extended, unreserved or lost)",
+			},
+			"safe_wal_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of bytes that can be written to WAL such that this slot is not in danger of getting lost",
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if this slot is currently being used",
+			},
+			"conflicting": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if this slot has been invalidated due to a conflict with recovery",
+			},
 		},
 	}
 }
@@ -48,14 +92,31 @@ func resourcePostgreSQLReplicationSlotCreate(db *DBConnection, d *schema.Resourc
 	plugin := d.Get("plugin").(string)
 	databaseName := getDatabaseForReplicationSlot(d, db.client.databaseName)
 
+	args := []any{name, plugin}
+	params := []string{"$1", "$2"}
+	if d.Get("two_phase").(bool) {
+		if !db.featureSupported(featureReplicationSlotTwoPhase) {
+			return fmt.Errorf("two_phase attribute is supported only for postgres version 14 and above")
+		}
+		args = append(args, true)
+		params = append(params, fmt.Sprintf("twophase => $%d", len(args)))
+	}
+	if d.Get("failover").(bool) {
+		if !db.featureSupported(featureReplicationSlotFailover) {
+			return fmt.Errorf("failover attribute is supported only for postgres version 17 and above")
+		}
+		args = append(args, true)
+		params = append(params, fmt.Sprintf("failover => $%d", len(args)))
+	}
+
 	txn, err := startTransaction(db.client, databaseName)
 	if err != nil {
 		return err
 	}
 	defer deferredRollback(txn)
 
-	sql := "SELECT FROM pg_create_logical_replication_slot($1, $2)"
-	if _, err := txn.Exec(sql, name, plugin); err != nil {
+	sql := fmt.Sprintf("SELECT FROM pg_create_logical_replication_slot(%s)", strings.Join(params, ", "))
+	if _, err := txn.Exec(sql, args...); err != nil {
 		return err
 	}
 
@@ -118,10 +179,33 @@ func resourcePostgreSQLReplicationSlotReadImpl(db *DBConnection, d *schema.Resou
 	defer deferredRollback(txn)
 
 	var replicationSlotPlugin string
-	query := `SELECT plugin ` +
-		`FROM pg_catalog.pg_replication_slots ` +
-		`WHERE slot_name = $1 AND database = $2`
-	err = txn.QueryRow(query, replicationSlotName, database).Scan(&replicationSlotPlugin)
+	var restartLSN, confirmedFlushLSN, walStatus sql.NullString
+	var safeWALSize sql.NullInt64
+	var active, twoPhase, failover, conflicting sql.NullBool
+	columns := []string{"plugin", "restart_lsn::text", "confirmed_flush_lsn::text", "active"}
+	values := []any{&replicationSlotPlugin, &restartLSN, &confirmedFlushLSN, &active}
+	if db.featureSupported(featureReplicationSlotWALStatus) {
+		columns = append(columns, "wal_status", "safe_wal_size")
+		values = append(values, &walStatus, &safeWALSize)
+	}
+	if db.featureSupported(featureReplicationSlotTwoPhase) {
+		columns = append(columns, "two_phase")
+		values = append(values, &twoPhase)
+	}
+	if db.featureSupported(featureReplicationSlotConflicting) {
+		columns = append(columns, "conflicting")
+		values = append(values, &conflicting)
+	}
+	if db.featureSupported(featureReplicationSlotFailover) {
+		columns = append(columns, "failover")
+		values = append(values, &failover)
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM pg_catalog.pg_replication_slots WHERE slot_name = $1 AND database = $2",
+		strings.Join(columns, ", "),
+	)
+	err = txn.QueryRow(query, replicationSlotName, database).Scan(values...)
 	switch {
 	case err == sql.ErrNoRows:
 		log.Printf("[WARN] PostgreSQL ReplicationSlot (%s) not found for database %s", replicationSlotName, database)
@@ -134,6 +218,14 @@ func resourcePostgreSQLReplicationSlotReadImpl(db *DBConnection, d *schema.Resou
 	d.Set("name", replicationSlotName)
 	d.Set("plugin", replicationSlotPlugin)
 	d.Set("database", database)
+	d.Set("two_phase", twoPhase.Bool)
+	d.Set("failover", failover.Bool)
+	d.Set("restart_lsn", restartLSN.String)
+	d.Set("confirmed_flush_lsn", confirmedFlushLSN.String)
+	d.Set("wal_status", walStatus.String)
+	d.Set("safe_wal_size", safeWALSize.Int64)
+	d.Set("active", active.Bool)
+	d.Set("conflicting", conflicting.Bool)
 	d.SetId(generateReplicationSlotID(d, database))
 
 	return nil
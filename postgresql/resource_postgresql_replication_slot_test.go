@@ -150,6 +150,74 @@ func TestAccPostgresqlReplicationSlot_Database(t *testing.T) {
 	})
 }
 
+func TestAccPostgresqlReplicationSlot_TwoPhase(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testSuperuserPreCheck(t)
+			testCheckCompatibleVersion(t, featureReplicationSlotTwoPhase)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlReplicationSlotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "postgresql_replication_slot" "myslot" {
+					name      = "slot_two_phase"
+					plugin    = "test_decoding"
+					two_phase = true
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlReplicationSlotExists("postgresql_replication_slot.myslot"),
+					resource.TestCheckResourceAttr(
+						"postgresql_replication_slot.myslot", "two_phase", "true"),
+					resource.TestCheckResourceAttr(
+						"postgresql_replication_slot.myslot", "failover", "false"),
+					resource.TestCheckResourceAttr(
+						"postgresql_replication_slot.myslot", "active", "false"),
+					resource.TestCheckResourceAttr(
+						"postgresql_replication_slot.myslot", "wal_status", "reserved"),
+					resource.TestCheckResourceAttrSet(
+						"postgresql_replication_slot.myslot", "restart_lsn"),
+					resource.TestCheckResourceAttrSet(
+						"postgresql_replication_slot.myslot", "confirmed_flush_lsn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlReplicationSlot_Failover(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testSuperuserPreCheck(t)
+			testCheckCompatibleVersion(t, featureReplicationSlotFailover)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlReplicationSlotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "postgresql_replication_slot" "myslot" {
+					name     = "slot_failover"
+					plugin   = "pgoutput"
+					failover = true
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlReplicationSlotExists("postgresql_replication_slot.myslot"),
+					resource.TestCheckResourceAttr(
+						"postgresql_replication_slot.myslot", "failover", "true"),
+					resource.TestCheckResourceAttr(
+						"postgresql_replication_slot.myslot", "two_phase", "false"),
+					resource.TestCheckResourceAttr(
+						"postgresql_replication_slot.myslot", "conflicting", "false"),
+				),
+			},
+		},
+	})
+}
+
 func checkReplicationSlotExists(txn *sql.Tx, slotName string) (bool, error) {
 	var _rez bool
 	err := txn.QueryRow("SELECT TRUE from pg_catalog.pg_replication_slots d WHERE slot_name=$1", slotName).Scan(&_rez)
@@ -35,9 +35,11 @@ const (
 	roleSuperuserAttr                       = "superuser"
 	roleValidUntilAttr                      = "valid_until"
 	roleRolesAttr                           = "roles"
+	roleRolesOptionsAttr                    = "roles_options"
 	roleSearchPathAttr                      = "search_path"
 	roleStatementTimeoutAttr                = "statement_timeout"
 	roleAssumeRoleAttr                      = "assume_role"
+	roleParametersAttr                      = "parameters"
 
 	// Deprecated options
 	roleDepEncryptedAttr = "encrypted"
@@ -96,6 +98,43 @@ func resourcePostgreSQLRole() *schema.Resource {
 				MinItems:    0,
 				Description: "Role(s) to grant to this new role",
 			},
+			roleRolesOptionsAttr: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Membership options of the roles granted with the roles attribute",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The granted role, which has to be in roles",
+						},
+						"with_admin_option": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Permit this role to grant the membership to others",
+						},
+						"inherit_option": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether this role inherits the privileges of the granted role",
+						},
+						"set_option": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether this role can SET ROLE to the granted role",
+						},
+						"granted_by": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The role recorded as the grantor of the membership",
+						},
+					},
+				},
+			},
 			roleSearchPathAttr: {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -193,11 +232,40 @@ func resourcePostgreSQLRole() *schema.Resource {
 				Optional:    true,
 				Description: "Role to switch to at login",
 			},
+			roleParametersAttr: {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "Configuration parameters set for the role (ALTER ROLE ... SET), e.g. work_mem or lock_timeout",
+				ValidateFunc: validateRoleParameters,
+			},
 		},
 	}
 }
 
+// roleDedicatedParameters are the rolconfig entries managed by their own attribute
+// and ignored by the parameters attribute.
+var roleDedicatedParameters = map[string]string{
+	roleSearchPathAttr:                      roleSearchPathAttr,
+	roleStatementTimeoutAttr:                roleStatementTimeoutAttr,
+	roleIdleInTransactionSessionTimeoutAttr: roleIdleInTransactionSessionTimeoutAttr,
+	"role":                                  roleAssumeRoleAttr,
+}
+
+func validateRoleParameters(v any, key string) (warnings []string, errs []error) {
+	for name := range v.(map[string]any) {
+		if attr, ok := roleDedicatedParameters[strings.ToLower(name)]; ok {
+			errs = append(errs, fmt.Errorf("%s: parameter %s must be set with the %s attribute", key, name, attr))
+		}
+	}
+	return warnings, errs
+}
+
 func resourcePostgreSQLRoleCreate(db *DBConnection, d *schema.ResourceData) error {
+	if err := validateRolesOptions(db, d); err != nil {
+		return err
+	}
+
 	txn, err := startTransaction(db.client, "")
 	if err != nil {
 		return err
@@ -370,6 +438,10 @@ func resourcePostgreSQLRoleCreate(db *DBConnection, d *schema.ResourceData) erro
 		return err
 	}
 
+	if err = setRoleParameters(txn, d); err != nil {
+		return err
+	}
+
 	if err = txn.Commit(); err != nil {
 		return fmt.Errorf("could not commit transaction: %w", err)
 	}
@@ -530,6 +602,11 @@ func resourcePostgreSQLRoleReadImpl(db *DBConnection, d *schema.ResourceData) er
 	}
 
 	d.Set(roleIdleInTransactionSessionTimeoutAttr, idleInTransactionSessionTimeout)
+	d.Set(roleParametersAttr, readRoleParameters(roleConfig))
+
+	if err := readRolesOptions(db, d, roleName); err != nil {
+		return err
+	}
 
 	d.SetId(roleName)
 
@@ -607,6 +684,15 @@ func readAssumeRole(roleConfig pq.ByteaArray) string {
 	return res
 }
 
+// readRoleParameters returns the rolconfig entries which are not managed by a dedicated attribute.
+func readRoleParameters(roleConfig pq.ByteaArray) map[string]string {
+	parameters := readParameters(roleConfig)
+	for name := range roleDedicatedParameters {
+		delete(parameters, name)
+	}
+	return parameters
+}
+
 // readRolePassword reads password either from Postgres if admin user is a superuser
 // or only from Terraform state.
 func readRolePassword(db *DBConnection, d *schema.ResourceData, roleCanLogin bool) (string, error) {
@@ -669,6 +755,10 @@ func readRolePassword(db *DBConnection, d *schema.ResourceData, roleCanLogin boo
 }
 
 func resourcePostgreSQLRoleUpdate(db *DBConnection, d *schema.ResourceData) error {
+	if err := validateRolesOptions(db, d); err != nil {
+		return err
+	}
+
 	txn, err := startTransaction(db.client, "")
 	if err != nil {
 		return err
@@ -749,6 +839,10 @@ func resourcePostgreSQLRoleUpdate(db *DBConnection, d *schema.ResourceData) erro
 		return err
 	}
 
+	if err = setRoleParameters(txn, d); err != nil {
+		return err
+	}
+
 	if err = txn.Commit(); err != nil {
 		return fmt.Errorf("could not commit transaction: %w", err)
 	}
@@ -1017,8 +1111,15 @@ func revokeRoles(txn *sql.Tx, d *schema.ResourceData) error {
 		grantedRoles = append(grantedRoles, grantedRole)
 	}
 
+	// Memberships granted by another role have to be revoked with the same grantor
+	oldOptions, _ := d.GetChange(roleRolesOptionsAttr)
+	grantors := map[string]string{}
+	for grantedRole, options := range rolesOptionsByRole(oldOptions.(*schema.Set)) {
+		grantors[grantedRole] = options.grantedBy
+	}
+
 	for _, grantedRole := range grantedRoles {
-		query = fmt.Sprintf("REVOKE %s FROM %s", pq.QuoteIdentifier(grantedRole), pq.QuoteIdentifier(role))
+		query = createRevokeRoleMembershipQuery(grantedRole, role, grantors[grantedRole])
 
 		if _, err := txn.Exec(query); err != nil {
 			return fmt.Errorf("could not revoke role %s from %s: %w", string(grantedRole), role, err)
@@ -1030,11 +1131,10 @@ func revokeRoles(txn *sql.Tx, d *schema.ResourceData) error {
 
 func grantRoles(txn *sql.Tx, d *schema.ResourceData) error {
 	role := d.Get(roleNameAttr).(string)
+	rolesOptions := rolesOptionsByRole(d.Get(roleRolesOptionsAttr).(*schema.Set))
 
 	for _, grantingRole := range d.Get("roles").(*schema.Set).List() {
-		query := fmt.Sprintf(
-			"GRANT %s TO %s", pq.QuoteIdentifier(grantingRole.(string)), pq.QuoteIdentifier(role),
-		)
+		query := createRoleMembershipQuery(grantingRole.(string), role, rolesOptions[grantingRole.(string)])
 		if _, err := txn.Exec(query); err != nil {
 			return fmt.Errorf("could not grant role %s to %s: %w", grantingRole, role, err)
 		}
@@ -1042,6 +1142,114 @@ func grantRoles(txn *sql.Tx, d *schema.ResourceData) error {
 	return nil
 }
 
+// rolesOptionsByRole indexes the roles_options entries by granted role.
+func rolesOptionsByRole(rolesOptions *schema.Set) map[string]roleMembershipOptions {
+	result := map[string]roleMembershipOptions{}
+	for _, raw := range rolesOptions.List() {
+		entry := raw.(map[string]any)
+		inherit := entry["inherit_option"].(bool)
+		set := entry["set_option"].(bool)
+		result[entry["role"].(string)] = roleMembershipOptions{
+			admin:     entry["with_admin_option"].(bool),
+			inherit:   &inherit,
+			set:       &set,
+			grantedBy: entry["granted_by"].(string),
+		}
+	}
+	return result
+}
+
+// validateRolesOptions checks that the roles_options entries refer to granted roles
+// and that the Postgres version supports them.
+func validateRolesOptions(db *DBConnection, d *schema.ResourceData) error {
+	rolesOptions := d.Get(roleRolesOptionsAttr).(*schema.Set)
+	if rolesOptions.Len() == 0 {
+		return nil
+	}
+
+	if !db.featureSupported(featureRoleMembershipOptions) {
+		return fmt.Errorf(
+			"%s is not supported for this Postgres version (%s)",
+			roleRolesOptionsAttr, db.version,
+		)
+	}
+
+	roles := d.Get(roleRolesAttr).(*schema.Set)
+	seen := map[string]bool{}
+	for _, raw := range rolesOptions.List() {
+		grantedRole := raw.(map[string]any)["role"].(string)
+		if !roles.Contains(grantedRole) {
+			return fmt.Errorf("%s: role %s is not in %s", roleRolesOptionsAttr, grantedRole, roleRolesAttr)
+		}
+		if seen[grantedRole] {
+			return fmt.Errorf("%s: role %s is declared more than once", roleRolesOptionsAttr, grantedRole)
+		}
+		seen[grantedRole] = true
+	}
+
+	return nil
+}
+
+// readRolesOptions reads the membership options of the roles declared in roles_options,
+// the memberships without options being only managed by the roles attribute.
+func readRolesOptions(db *DBConnection, d *schema.ResourceData, roleName string) error {
+	rolesOptions := d.Get(roleRolesOptionsAttr).(*schema.Set)
+	if rolesOptions.Len() == 0 || !db.featureSupported(featureRoleMembershipOptions) {
+		return nil
+	}
+
+	rows, err := db.Query(`SELECT
+		pg_get_userbyid(roleid), admin_option, inherit_option, set_option, pg_get_userbyid(grantor)
+		FROM pg_catalog.pg_auth_members
+		WHERE member = (SELECT oid FROM pg_catalog.pg_roles WHERE rolname = $1)
+		ORDER BY grantor`, roleName)
+	if err != nil {
+		return fmt.Errorf("could not read roles options of role %s: %w", roleName, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("error closing rows: %v", err)
+		}
+	}()
+
+	memberships := map[string][]map[string]any{}
+	for rows.Next() {
+		var grantedRole, grantedBy string
+		var admin, inherit, set bool
+		if err := rows.Scan(&grantedRole, &admin, &inherit, &set, &grantedBy); err != nil {
+			return fmt.Errorf("could not scan roles options of role %s: %w", roleName, err)
+		}
+		memberships[grantedRole] = append(memberships[grantedRole], map[string]any{
+			"role":              grantedRole,
+			"with_admin_option": admin,
+			"inherit_option":    inherit,
+			"set_option":        set,
+			"granted_by":        grantedBy,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("could not read roles options of role %s: %w", roleName, err)
+	}
+
+	var result []any
+	for _, raw := range rolesOptions.List() {
+		entry := raw.(map[string]any)
+		for _, membership := range memberships[entry["role"].(string)] {
+			// There is one membership per grantor, keep the configured one if any.
+			if grantedBy := entry["granted_by"].(string); grantedBy != "" && grantedBy != membership["granted_by"] {
+				continue
+			}
+			if entry["granted_by"].(string) == "" {
+				membership["granted_by"] = ""
+			}
+			result = append(result, membership)
+			break
+		}
+	}
+
+	return d.Set(roleRolesOptionsAttr, result)
+}
+
 func alterSearchPath(txn *sql.Tx, d *schema.ResourceData) error {
 	role := d.Get(roleNameAttr).(string)
 	searchPathInterface := d.Get(roleSearchPathAttr).([]any)
@@ -1155,3 +1363,15 @@ func getWO(d *schema.ResourceData, attribute string) (string, bool) {
 	}
 	return raw.AsString(), true // return the value
 }
+
+// setRoleParameters resets the parameters removed from the configuration and sets the new or modified ones.
+func setRoleParameters(txn *sql.Tx, d *schema.ResourceData) error {
+	if !d.HasChange(roleParametersAttr) {
+		return nil
+	}
+
+	oraw, nraw := d.GetChange(roleParametersAttr)
+	target := fmt.Sprintf("ROLE %s", pq.QuoteIdentifier(d.Get(roleNameAttr).(string)))
+
+	return alterParameters(txn, target, oraw.(map[string]any), nraw.(map[string]any))
+}
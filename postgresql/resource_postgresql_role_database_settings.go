@@ -0,0 +1,205 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/lib/pq"
+)
+
+const (
+	roleDBSettingsRoleAttr       = "role"
+	roleDBSettingsDatabaseAttr   = "database"
+	roleDBSettingsParametersAttr = "parameters"
+)
+
+func resourcePostgreSQLRoleDatabaseSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: PGResourceFunc(resourcePostgreSQLRoleDatabaseSettingsCreate),
+		Read:   PGResourceFunc(resourcePostgreSQLRoleDatabaseSettingsRead),
+		Update: PGResourceFunc(resourcePostgreSQLRoleDatabaseSettingsUpdate),
+		Delete: PGResourceFunc(resourcePostgreSQLRoleDatabaseSettingsDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			roleDBSettingsRoleAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the role to which the settings apply",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			roleDBSettingsDatabaseAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the database in which the settings apply",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			roleDBSettingsParametersAttr: {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Configuration parameters set for the role when connected to the database",
+			},
+		},
+	}
+}
+
+func resourcePostgreSQLRoleDatabaseSettingsCreate(db *DBConnection, d *schema.ResourceData) error {
+	if err := setRoleDatabaseSettings(db, d); err != nil {
+		return err
+	}
+
+	d.SetId(generateRoleDatabaseSettingsID(d))
+
+	return resourcePostgreSQLRoleDatabaseSettingsReadImpl(db, d)
+}
+
+func resourcePostgreSQLRoleDatabaseSettingsRead(db *DBConnection, d *schema.ResourceData) error {
+	return resourcePostgreSQLRoleDatabaseSettingsReadImpl(db, d)
+}
+
+func resourcePostgreSQLRoleDatabaseSettingsReadImpl(db *DBConnection, d *schema.ResourceData) error {
+	roleName, databaseName, err := getRoleDatabaseSettingsNames(d)
+	if err != nil {
+		return err
+	}
+
+	var roleOID, databaseOID sql.NullInt64
+	err = db.QueryRow(
+		"SELECT (SELECT oid FROM pg_catalog.pg_roles WHERE rolname = $1), (SELECT oid FROM pg_catalog.pg_database WHERE datname = $2)",
+		roleName, databaseName,
+	).Scan(&roleOID, &databaseOID)
+	if err != nil {
+		return fmt.Errorf("error reading role %s and database %s: %w", roleName, databaseName, err)
+	}
+
+	if !roleOID.Valid || !databaseOID.Valid {
+		log.Printf("[WARN] PostgreSQL role (%s) or database (%s) not found", roleName, databaseName)
+		d.SetId("")
+		return nil
+	}
+
+	var setConfig pq.ByteaArray
+	err = db.QueryRow(
+		"SELECT setconfig FROM pg_catalog.pg_db_role_setting WHERE setrole = $1 AND setdatabase = $2",
+		roleOID.Int64, databaseOID.Int64,
+	).Scan(&setConfig)
+	switch {
+	case err == sql.ErrNoRows:
+		// All the settings have been reset, the plan will set them back.
+		setConfig = nil
+	case err != nil:
+		return fmt.Errorf("error reading settings of role %s in database %s: %w", roleName, databaseName, err)
+	}
+
+	d.Set(roleDBSettingsRoleAttr, roleName)
+	d.Set(roleDBSettingsDatabaseAttr, databaseName)
+	d.Set(roleDBSettingsParametersAttr, readParameters(setConfig))
+	d.SetId(generateRoleDatabaseSettingsID(d))
+
+	return nil
+}
+
+func resourcePostgreSQLRoleDatabaseSettingsUpdate(db *DBConnection, d *schema.ResourceData) error {
+	if err := setRoleDatabaseSettings(db, d); err != nil {
+		return err
+	}
+
+	return resourcePostgreSQLRoleDatabaseSettingsReadImpl(db, d)
+}
+
+func setRoleDatabaseSettings(db *DBConnection, d *schema.ResourceData) error {
+	if !d.HasChange(roleDBSettingsParametersAttr) {
+		return nil
+	}
+
+	txn, err := startTransaction(db.client, "")
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	roleName := d.Get(roleDBSettingsRoleAttr).(string)
+	if err := pgLockRole(txn, roleName); err != nil {
+		return err
+	}
+
+	oraw, nraw := d.GetChange(roleDBSettingsParametersAttr)
+	if err := alterParameters(txn, getRoleDatabaseSettingsTarget(d), oraw.(map[string]any), nraw.(map[string]any)); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func resourcePostgreSQLRoleDatabaseSettingsDelete(db *DBConnection, d *schema.ResourceData) error {
+	txn, err := startTransaction(db.client, "")
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	roleName := d.Get(roleDBSettingsRoleAttr).(string)
+	if err := pgLockRole(txn, roleName); err != nil {
+		return err
+	}
+
+	sql := fmt.Sprintf("ALTER %s RESET ALL", getRoleDatabaseSettingsTarget(d))
+	if _, err := txn.Exec(sql); err != nil {
+		return fmt.Errorf("could not reset settings of role %s: %w", roleName, err)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getRoleDatabaseSettingsTarget(d *schema.ResourceData) string {
+	return fmt.Sprintf(
+		"ROLE %s IN DATABASE %s",
+		pq.QuoteIdentifier(d.Get(roleDBSettingsRoleAttr).(string)),
+		pq.QuoteIdentifier(d.Get(roleDBSettingsDatabaseAttr).(string)),
+	)
+}
+
+func generateRoleDatabaseSettingsID(d *schema.ResourceData) string {
+	return strings.Join([]string{
+		d.Get(roleDBSettingsDatabaseAttr).(string),
+		d.Get(roleDBSettingsRoleAttr).(string),
+	}, ".")
+}
+
+// getRoleDatabaseSettingsNames returns the role and database names. If we are importing this
+// resource, they will be parsed from the resource ID (it will return an error if parsing failed)
+// otherwise they will be simply get from the state.
+func getRoleDatabaseSettingsNames(d *schema.ResourceData) (string, string, error) {
+	roleName := d.Get(roleDBSettingsRoleAttr).(string)
+	databaseName := d.Get(roleDBSettingsDatabaseAttr).(string)
+
+	// When importing, we have to parse the ID to find role and database names.
+	if roleName == "" {
+		parsed := strings.Split(d.Id(), ".")
+		if len(parsed) != 2 {
+			return "", "", fmt.Errorf("role database settings ID %s has not the expected format 'database.role': %v", d.Id(), parsed)
+		}
+		databaseName = parsed[0]
+		roleName = parsed[1]
+	}
+	return roleName, databaseName, nil
+}
@@ -0,0 +1,146 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/lib/pq"
+)
+
+func TestAccPostgresqlRoleDatabaseSettings_Basic(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	dbName, roleName := getTestDBNames(dbSuffix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlRoleDatabaseSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccPostgresqlRoleDatabaseSettingsConfig, roleName, dbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleDatabaseSettings(roleName, dbName, map[string]string{
+						"search_path":       `"$user", public`,
+						"statement_timeout": "5min",
+					}),
+					resource.TestCheckResourceAttr("postgresql_role_database_settings.test", "id", fmt.Sprintf("%s.%s", dbName, roleName)),
+					resource.TestCheckResourceAttr("postgresql_role_database_settings.test", "parameters.%", "2"),
+					resource.TestCheckResourceAttr("postgresql_role_database_settings.test", "parameters.search_path", `"$user", public`),
+					resource.TestCheckResourceAttr("postgresql_role_database_settings.test", "parameters.statement_timeout", "5min"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccPostgresqlRoleDatabaseSettingsConfigUpdated, roleName, dbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleDatabaseSettings(roleName, dbName, map[string]string{
+						"lock_timeout": "10s",
+					}),
+					resource.TestCheckResourceAttr("postgresql_role_database_settings.test", "parameters.%", "1"),
+					resource.TestCheckResourceAttr("postgresql_role_database_settings.test", "parameters.lock_timeout", "10s"),
+				),
+			},
+			{
+				ResourceName:      "postgresql_role_database_settings.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s.%s", dbName, roleName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPostgresqlRoleDatabaseSettingsDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "postgresql_role_database_settings" {
+			continue
+		}
+
+		settings, err := getRoleDatabaseSettings(rs.Primary.Attributes["role"], rs.Primary.Attributes["database"])
+		if err != nil {
+			return err
+		}
+
+		if len(settings) > 0 {
+			return fmt.Errorf("Role database settings still exist after destroy: %v", settings)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckRoleDatabaseSettings(roleName, dbName string, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		settings, err := getRoleDatabaseSettings(roleName, dbName)
+		if err != nil {
+			return err
+		}
+
+		if len(settings) != len(expected) {
+			return fmt.Errorf("expected settings %v, got %v", expected, settings)
+		}
+		for k, v := range expected {
+			if settings[k] != v {
+				return fmt.Errorf("expected settings %v, got %v", expected, settings)
+			}
+		}
+
+		return nil
+	}
+}
+
+func getRoleDatabaseSettings(roleName, dbName string) (map[string]string, error) {
+	client := testAccProvider.Meta().(*Client)
+	db, err := client.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	var setConfig pq.ByteaArray
+	err = db.QueryRow(
+		"SELECT setconfig FROM pg_catalog.pg_db_role_setting s "+
+			"JOIN pg_catalog.pg_roles r ON r.oid = s.setrole "+
+			"JOIN pg_catalog.pg_database d ON d.oid = s.setdatabase "+
+			"WHERE r.rolname = $1 AND d.datname = $2",
+		roleName, dbName,
+	).Scan(&setConfig)
+	switch {
+	case err == sql.ErrNoRows:
+		return map[string]string{}, nil
+	case err != nil:
+		return nil, fmt.Errorf("could not read role database settings: %w", err)
+	}
+
+	return readParameters(setConfig), nil
+}
+
+const testAccPostgresqlRoleDatabaseSettingsConfig = `
+resource "postgresql_role_database_settings" "test" {
+  role     = "%s"
+  database = "%s"
+
+  parameters = {
+    search_path       = "\"$user\", public"
+    statement_timeout = "5min"
+  }
+}
+`
+
+const testAccPostgresqlRoleDatabaseSettingsConfigUpdated = `
+resource "postgresql_role_database_settings" "test" {
+  role     = "%s"
+  database = "%s"
+
+  parameters = {
+    lock_timeout = "10s"
+  }
+}
+`
@@ -12,6 +12,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccPostgresqlRole_Basic(t *testing.T) {
@@ -123,6 +125,11 @@ resource "postgresql_role" "update_role" {
   statement_timeout = 30000
   idle_in_transaction_session_timeout = 60000
   assume_role = "${postgresql_role.group_role.name}"
+
+  parameters = {
+    work_mem     = "64MB"
+    lock_timeout = "5s"
+  }
 }
 `
 	resource.Test(t, resource.TestCase{
@@ -168,6 +175,9 @@ resource "postgresql_role" "update_role" {
 					resource.TestCheckResourceAttr("postgresql_role.update_role", "statement_timeout", "30000"),
 					resource.TestCheckResourceAttr("postgresql_role.update_role", "idle_in_transaction_session_timeout", "60000"),
 					resource.TestCheckResourceAttr("postgresql_role.update_role", "assume_role", "group_role"),
+					resource.TestCheckResourceAttr("postgresql_role.update_role", "parameters.%", "2"),
+					resource.TestCheckResourceAttr("postgresql_role.update_role", "parameters.work_mem", "64MB"),
+					resource.TestCheckResourceAttr("postgresql_role.update_role", "parameters.lock_timeout", "5s"),
 					testAccCheckRoleCanLogin(t, "update_role2", "titi"),
 				),
 			},
@@ -186,6 +196,7 @@ resource "postgresql_role" "update_role" {
 					resource.TestCheckResourceAttr("postgresql_role.update_role", "statement_timeout", "0"),
 					resource.TestCheckResourceAttr("postgresql_role.update_role", "idle_in_transaction_session_timeout", "0"),
 					resource.TestCheckResourceAttr("postgresql_role.update_role", "assume_role", ""),
+					resource.TestCheckResourceAttr("postgresql_role.update_role", "parameters.%", "0"),
 					testAccCheckRoleCanLogin(t, "update_role", "toto"),
 				),
 			},
@@ -193,6 +204,29 @@ resource "postgresql_role" "update_role" {
 	})
 }
 
+func TestReadRoleParameters(t *testing.T) {
+	roleConfig := pq.ByteaArray{
+		[]byte("search_path=public, foo"),
+		[]byte("statement_timeout=1000"),
+		[]byte("role=other"),
+		[]byte("work_mem=64MB"),
+		[]byte("pgaudit.log=read, write"),
+	}
+
+	assert.Equal(t, map[string]string{
+		"work_mem":    "64MB",
+		"pgaudit.log": "read, write",
+	}, readRoleParameters(roleConfig))
+}
+
+func TestValidateRoleParameters(t *testing.T) {
+	_, errs := validateRoleParameters(map[string]any{"work_mem": "64MB"}, roleParametersAttr)
+	assert.Empty(t, errs)
+
+	_, errs = validateRoleParameters(map[string]any{"statement_timeout": "1000"}, roleParametersAttr)
+	assert.Len(t, errs, 1)
+}
+
 // Test to create a role with admin user (usually postgres) granted to it
 // There were a bug on RDS like setup (with a non-superuser postgres role)
 // where it couldn't delete the role in this case.
@@ -238,6 +272,60 @@ resource "postgresql_role" "test_role" {
 	})
 }
 
+func TestAccPostgresqlRole_RolesOptions(t *testing.T) {
+	roleConfig := `
+resource "postgresql_role" "group_role" {
+  name = "group_role"
+}
+
+resource "postgresql_role" "other_group_role" {
+  name = "other_group_role"
+}
+
+resource "postgresql_role" "test_role" {
+  name  = "test_role"
+  roles = [
+    postgresql_role.group_role.name,
+    postgresql_role.other_group_role.name,
+  ]
+
+  roles_options {
+    role           = postgresql_role.group_role.name
+    inherit_option = %t
+    set_option     = false
+  }
+}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testCheckCompatibleVersion(t, featureRoleMembershipOptions)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(roleConfig, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlRoleExists("test_role", []string{"group_role", "other_group_role"}, nil),
+					resource.TestCheckResourceAttr("postgresql_role.test_role", "roles_options.#", "1"),
+					resource.TestCheckResourceAttr("postgresql_role.test_role", "roles_options.0.role", "group_role"),
+					resource.TestCheckResourceAttr("postgresql_role.test_role", "roles_options.0.inherit_option", "false"),
+					resource.TestCheckResourceAttr("postgresql_role.test_role", "roles_options.0.set_option", "false"),
+					resource.TestCheckResourceAttr("postgresql_role.test_role", "roles_options.0.with_admin_option", "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(roleConfig, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_role.test_role", "roles_options.0.inherit_option", "true"),
+					resource.TestCheckResourceAttr("postgresql_role.test_role", "roles_options.0.set_option", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPostgresqlRoleDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*Client)
 
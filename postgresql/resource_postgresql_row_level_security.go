@@ -0,0 +1,241 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	rlsDatabaseAttr = "database"
+	rlsSchemaAttr   = "schema"
+	rlsTableAttr    = "table"
+	rlsForceAttr    = "force"
+)
+
+func resourcePostgreSQLRowLevelSecurity() *schema.Resource {
+	return &schema.Resource{
+		Create: PGResourceFunc(resourcePostgreSQLRowLevelSecurityCreate),
+		Read:   PGResourceFunc(resourcePostgreSQLRowLevelSecurityRead),
+		Update: PGResourceFunc(resourcePostgreSQLRowLevelSecurityUpdate),
+		Delete: PGResourceFunc(resourcePostgreSQLRowLevelSecurityDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			rlsDatabaseAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The database of the table. If not specified, the provider default database is used",
+			},
+			rlsSchemaAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The schema of the table. Defaults to public",
+			},
+			rlsTableAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the table on which row level security is enabled",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			rlsForceAttr: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the row level security policies also apply to the table owner",
+			},
+		},
+	}
+}
+
+func resourcePostgreSQLRowLevelSecurityCreate(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureRLS) {
+		return fmt.Errorf(
+			"postgresql_row_level_security resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	database := getDatabase(d, db.client.databaseName)
+
+	if err := setRowLevelSecurity(db, d, database, true, d.Get(rlsForceAttr).(bool)); err != nil {
+		return err
+	}
+
+	d.SetId(generateRowLevelSecurityID(d, database))
+
+	return resourcePostgreSQLRowLevelSecurityReadImpl(db, d)
+}
+
+func resourcePostgreSQLRowLevelSecurityRead(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureRLS) {
+		return fmt.Errorf(
+			"postgresql_row_level_security resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	return resourcePostgreSQLRowLevelSecurityReadImpl(db, d)
+}
+
+func resourcePostgreSQLRowLevelSecurityReadImpl(db *DBConnection, d *schema.ResourceData) error {
+	database, schemaName, tableName, err := getDBRowLevelSecurityTableName(d, db.client.databaseName)
+	if err != nil {
+		return err
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	var enabled, force bool
+	query := `SELECT c.relrowsecurity, c.relforcerowsecurity FROM pg_catalog.pg_class c ` +
+		`JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace ` +
+		`WHERE n.nspname = $1 AND c.relname = $2 AND c.relkind IN ('r', 'p')`
+	err = txn.QueryRow(query, schemaName, tableName).Scan(&enabled, &force)
+	switch {
+	case err == sql.ErrNoRows:
+		log.Printf("[WARN] PostgreSQL table (%s.%s) not found in database %s", schemaName, tableName, database)
+		d.SetId("")
+		return nil
+	case err != nil:
+		return fmt.Errorf("error reading row level security: %w", err)
+	}
+
+	if !enabled {
+		log.Printf("[WARN] PostgreSQL row level security is disabled on table %s.%s in database %s", schemaName, tableName, database)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(rlsDatabaseAttr, database)
+	d.Set(rlsSchemaAttr, schemaName)
+	d.Set(rlsTableAttr, tableName)
+	d.Set(rlsForceAttr, force)
+	d.SetId(generateRowLevelSecurityID(d, database))
+
+	return nil
+}
+
+func resourcePostgreSQLRowLevelSecurityUpdate(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureRLS) {
+		return fmt.Errorf(
+			"postgresql_row_level_security resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	database := getDatabase(d, db.client.databaseName)
+
+	if err := setRowLevelSecurity(db, d, database, true, d.Get(rlsForceAttr).(bool)); err != nil {
+		return err
+	}
+
+	return resourcePostgreSQLRowLevelSecurityReadImpl(db, d)
+}
+
+func resourcePostgreSQLRowLevelSecurityDelete(db *DBConnection, d *schema.ResourceData) error {
+	if !db.featureSupported(featureRLS) {
+		return fmt.Errorf(
+			"postgresql_row_level_security resource is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	database := getDatabase(d, db.client.databaseName)
+
+	if err := setRowLevelSecurity(db, d, database, false, false); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func setRowLevelSecurity(db *DBConnection, d *schema.ResourceData, database string, enable, force bool) error {
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	schemaName := "public"
+	if v, ok := d.GetOk(rlsSchemaAttr); ok {
+		schemaName = v.(string)
+	}
+	tableName := quoteTableName(fmt.Sprintf("%s.%s", schemaName, d.Get(rlsTableAttr).(string)))
+
+	enableMode := "ENABLE"
+	if !enable {
+		enableMode = "DISABLE"
+	}
+	forceMode := "FORCE"
+	if !force {
+		forceMode = "NO FORCE"
+	}
+
+	sql := fmt.Sprintf(
+		"ALTER TABLE %s %s ROW LEVEL SECURITY, %s ROW LEVEL SECURITY",
+		tableName, enableMode, forceMode,
+	)
+	if _, err := txn.Exec(sql); err != nil {
+		return fmt.Errorf("error updating row level security on table %s: %w", tableName, err)
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing row level security: %w", err)
+	}
+
+	return nil
+}
+
+func generateRowLevelSecurityID(d *schema.ResourceData, databaseName string) string {
+	schemaName := "public"
+	if v, ok := d.GetOk(rlsSchemaAttr); ok {
+		schemaName = v.(string)
+	}
+
+	return strings.Join([]string{
+		getDatabase(d, databaseName),
+		schemaName,
+		d.Get(rlsTableAttr).(string),
+	}, ".")
+}
+
+// getDBRowLevelSecurityTableName returns database, schema and table name. If we are importing this
+// resource, they will be parsed from the resource ID (it will return an error if parsing failed)
+// otherwise they will be simply get from the state.
+func getDBRowLevelSecurityTableName(d *schema.ResourceData, databaseName string) (string, string, string, error) {
+	database := getDatabase(d, databaseName)
+	schemaName := "public"
+	if v, ok := d.GetOk(rlsSchemaAttr); ok {
+		schemaName = v.(string)
+	}
+	tableName := d.Get(rlsTableAttr).(string)
+
+	// When importing, we have to parse the ID to find database, schema and table names.
+	if tableName == "" {
+		parsed := strings.Split(d.Id(), ".")
+		if len(parsed) != 3 {
+			return "", "", "", fmt.Errorf("row level security ID %s has not the expected format 'database.schema.table': %v", d.Id(), parsed)
+		}
+		database = parsed[0]
+		schemaName = parsed[1]
+		tableName = parsed[2]
+	}
+	return database, schemaName, tableName, nil
+}
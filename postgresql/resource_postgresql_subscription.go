@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -11,6 +12,33 @@ import (
 	"github.com/lib/pq"
 )
 
+// subscriptionOption is an option of CREATE SUBSCRIPTION ... WITH (...) read back from pg_subscription.
+type subscriptionOption struct {
+	// name is the name of the option and of the resource attribute.
+	name string
+	// column is the pg_subscription column in which the option is stored.
+	column  string
+	feature featureName
+	// version is the first Postgres version supporting the option, used in error messages.
+	version string
+	// updatable is true if the option can be changed with ALTER SUBSCRIPTION ... SET (...)
+	updatable bool
+	// requiresDisabled is true if the subscription has to be disabled to change the option.
+	requiresDisabled bool
+}
+
+var subscriptionOptions = []subscriptionOption{
+	{name: "streaming", column: "substream", feature: featureSubscriptionStreaming, version: "14", updatable: true},
+	{name: "binary", column: "subbinary", feature: featureSubscriptionBinary, version: "14", updatable: true},
+	{name: "two_phase", column: "subtwophasestate", feature: featureSubscriptionTwoPhase, version: "15"},
+	{name: "disable_on_error", column: "subdisableonerr", feature: featureSubscriptionDisableOnError, version: "15", updatable: true},
+	{name: "synchronous_commit", column: "subsynccommit", feature: featurePublication, version: "10", updatable: true},
+	{name: "origin", column: "suborigin", feature: featureSubscriptionOrigin, version: "16", updatable: true},
+	{name: "password_required", column: "subpasswordrequired", feature: featureSubscriptionPasswordRequired, version: "16", updatable: true},
+	{name: "run_as_owner", column: "subrunasowner", feature: featureSubscriptionRunAsOwner, version: "16", updatable: true},
+	{name: "failover", column: "subfailover", feature: featureSubscriptionFailover, version: "17", updatable: true, requiresDisabled: true},
+}
+
 func resourcePostgreSQLSubscription() *schema.Resource {
 	return &schema.Resource{
 		Create:   PGResourceFunc(resourcePostgreSQLSubscriptionCreate),
@@ -46,7 +74,6 @@ func resourcePostgreSQLSubscription() *schema.Resource {
 			"publications": {
 				Type:        schema.TypeSet,
 				Required:    true,
-				ForceNew:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Names of the publications on the publisher to subscribe to",
 			},
@@ -80,13 +107,82 @@ func resourcePostgreSQLSubscription() *schema.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     true,
-				Description: "Specifies whether to copy pre-existing data in the publications that are being subscribed to when the replication starts. The default is true.",
+				Description: "Specifies whether to copy pre-existing data in the publications that are being subscribed to when the replication starts or when tables are added to the subscription. The default is true.",
+			},
+			"refresh_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Any change of this value refreshes the publications of the subscription (ALTER SUBSCRIPTION ... REFRESH PUBLICATION) to fetch the tables added on the publisher",
+			},
+			"table_sync_state": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The synchronization state (init, data_copy, finished_copy, synchronized or ready) of each table of the subscription",
 			},
 			"start_lsn": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "The LSN to start replication from when enabling a subscription. Can only be set when switching from an existing disabled subscription to enabled state.",
 			},
+			"streaming": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies whether to enable streaming of in-progress transactions (on, off or parallel)",
+				ValidateFunc: validation.StringInSlice([]string{"on", "off", "parallel"}, false),
+			},
+			"binary": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies whether the subscription will request the publisher to send the data in binary format",
+			},
+			"two_phase": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Specifies whether two-phase commit is enabled for this subscription",
+			},
+			"disable_on_error": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies whether the subscription should be automatically disabled if any errors are detected by subscription workers",
+			},
+			"synchronous_commit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The value of the synchronous_commit setting for the subscription's workers",
+				ValidateFunc: validation.StringInSlice([]string{"on", "off", "local", "remote_write", "remote_apply"}, false),
+			},
+			"origin": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies whether the subscription will request the publisher to only send changes that don't have an origin (none) or regardless of origin (any)",
+				ValidateFunc: validation.StringInSlice([]string{"any", "none"}, false),
+			},
+			"password_required": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies whether connections to the publisher made as a result of this subscription must use password authentication",
+			},
+			"run_as_owner": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies whether replication and triggers run as the subscription owner instead of the table owners",
+			},
+			"failover": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies whether the replication slots associated with the subscription are enabled to be synced to the standbys",
+			},
 		},
 	}
 }
@@ -102,6 +198,10 @@ func resourcePostgreSQLSubscriptionCreate(db *DBConnection, d *schema.ResourceDa
 		return fmt.Errorf("start_lsn cannot be set during subscription creation. LSN positioning only works when transitioning from disabled to enabled state with need to advance start lsn offset")
 	}
 
+	if err := validateSubscriptionOptions(db, d); err != nil {
+		return err
+	}
+
 	publications, err := getPublicationsForSubscription(d)
 	if err != nil {
 		return fmt.Errorf("could not get publications: %w", err)
@@ -173,9 +273,31 @@ func resourcePostgreSQLSubscriptionReadImpl(db *DBConnection, d *schema.Resource
 		return nil
 	}
 
+	tableSyncState, err := readSubscriptionTableSyncState(txn, subName)
+	if err != nil {
+		return err
+	}
+	d.Set("table_sync_state", tableSyncState)
+
+	// Options are cast to text as their types depend on the Postgres version (e.g.: substream is a boolean before Postgres 16)
+	columns := []string{"subconninfo", "subpublications", "subslotname", "subenabled"}
+	values := []any{&connInfo, pq.Array(&publications), &slotName, &enabled}
+	var options []subscriptionOption
+	optionValues := []string{}
+	for _, option := range subscriptionOptions {
+		if db.featureSupported(option.feature) {
+			options = append(options, option)
+			optionValues = append(optionValues, "")
+		}
+	}
+	for i, option := range options {
+		columns = append(columns, option.column+"::text")
+		values = append(values, &optionValues[i])
+	}
+
 	// pg_subscription requires superuser permissions, it is okay to fail here
-	query := "SELECT subconninfo, subpublications, subslotname, subenabled FROM pg_catalog.pg_subscription WHERE subname = $1"
-	err = txn.QueryRow(query, pqQuoteLiteral(subName)).Scan(&connInfo, pq.Array(&publications), &slotName, &enabled)
+	query := fmt.Sprintf("SELECT %s FROM pg_catalog.pg_subscription WHERE subname = $1", strings.Join(columns, ", "))
+	err = txn.QueryRow(query, pqQuoteLiteral(subName)).Scan(values...)
 
 	if err != nil {
 		// we already checked that the subscription exists
@@ -199,6 +321,10 @@ func resourcePostgreSQLSubscriptionReadImpl(db *DBConnection, d *schema.Resource
 		d.Set("conninfo", connInfo)
 		d.Set("publications", publications)
 		d.Set("enabled", enabled)
+
+		for i, option := range options {
+			d.Set(option.name, readSubscriptionOption(option.name, optionValues[i]))
+		}
 	}
 	d.Set("name", subName)
 	d.Set("database", databaseName)
@@ -216,10 +342,173 @@ func resourcePostgreSQLSubscriptionReadImpl(db *DBConnection, d *schema.Resource
 	return nil
 }
 
+// subscriptionTableSyncStates are the names of the pg_subscription_rel.srsubstate values.
+var subscriptionTableSyncStates = map[string]string{
+	"i": "init",
+	"d": "data_copy",
+	"f": "finished_copy",
+	"s": "synchronized",
+	"r": "ready",
+}
+
+func readSubscriptionTableSyncState(txn *sql.Tx, subName string) (map[string]string, error) {
+	// subname is only unique per database as pg_subscription is shared across the cluster.
+	query := `SELECT CONCAT(n.nspname, '.', c.relname), sr.srsubstate FROM pg_catalog.pg_subscription_rel sr ` +
+		`JOIN pg_catalog.pg_subscription s ON s.oid = sr.srsubid ` +
+		`JOIN pg_catalog.pg_class c ON c.oid = sr.srrelid ` +
+		`JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace ` +
+		`WHERE s.subname = $1 AND s.subdbid = (SELECT oid FROM pg_catalog.pg_database WHERE datname = current_database())`
+
+	rows, err := txn.Query(query, pqQuoteLiteral(subName))
+	if err != nil {
+		return nil, fmt.Errorf("could not read tables sync state of subscription %s: %w", subName, err)
+	}
+	defer rows.Close()
+
+	states := map[string]string{}
+	for rows.Next() {
+		var table, state string
+		if err := rows.Scan(&table, &state); err != nil {
+			return nil, fmt.Errorf("could not scan table sync state: %w", err)
+		}
+		if name, ok := subscriptionTableSyncStates[state]; ok {
+			state = name
+		}
+		states[table] = state
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read tables sync state of subscription %s: %w", subName, err)
+	}
+
+	return states, nil
+}
+
 func resourcePostgreSQLSubscriptionUpdate(db *DBConnection, d *schema.ResourceData) error {
+	if err := validateSubscriptionOptions(db, d); err != nil {
+		return err
+	}
+
+	optionsParams := getUpdatedOptionsParameters(d)
+	if !d.HasChange("enabled") && optionsParams == "" && !d.HasChange("publications") && !d.HasChange("refresh_trigger") {
+		return resourcePostgreSQLSubscriptionReadImpl(db, d)
+	}
+
 	subName := d.Get("name").(string)
 	databaseName := getDatabaseForSubscription(d, db.client.databaseName)
 
+	// Subscription operations cannot be done in a transaction
+	client := db.client.config.NewClient(databaseName)
+	conn, err := client.Connect()
+	if err != nil {
+		return fmt.Errorf("could not establish database connection: %w", err)
+	}
+
+	// Some options (e.g.: failover) can only be changed while the subscription is disabled,
+	// so the subscription is disabled before and enabled after updating them.
+	enabled := d.Get("enabled").(bool)
+	if !enabled {
+		if err := setSubscriptionEnabled(conn, d); err != nil {
+			return err
+		}
+	}
+
+	// The subscription stays enabled, it is disabled only while the options are updated.
+	wasEnabled, _ := d.GetChange("enabled")
+	disableForUpdate := enabled && wasEnabled.(bool) && subscriptionOptionsRequireDisabled(d)
+	if disableForUpdate {
+		sql := fmt.Sprintf("ALTER SUBSCRIPTION %s DISABLE", pq.QuoteIdentifier(subName))
+		if _, err := conn.Exec(sql); err != nil {
+			return fmt.Errorf("could not disable subscription %s to update its options: %w", subName, err)
+		}
+	}
+
+	if optionsParams != "" {
+		sql := fmt.Sprintf("ALTER SUBSCRIPTION %s SET (%s)", pq.QuoteIdentifier(subName), optionsParams)
+		if _, err := conn.Exec(sql); err != nil {
+			return fmt.Errorf("could not update subscription %s options: %w", subName, err)
+		}
+	}
+
+	if disableForUpdate {
+		sql := fmt.Sprintf("ALTER SUBSCRIPTION %s ENABLE", pq.QuoteIdentifier(subName))
+		if _, err := conn.Exec(sql); err != nil {
+			return fmt.Errorf("could not enable subscription %s after updating its options: %w", subName, err)
+		}
+	}
+
+	if enabled {
+		if err := setSubscriptionEnabled(conn, d); err != nil {
+			return err
+		}
+	}
+
+	// Refreshing the publications is only possible once the subscription is enabled.
+	if err := setSubscriptionPublications(conn, d); err != nil {
+		return err
+	}
+
+	return resourcePostgreSQLSubscriptionReadImpl(db, d)
+}
+
+func setSubscriptionPublications(conn *DBConnection, d *schema.ResourceData) error {
+	if !d.HasChange("publications") && !d.HasChange("refresh_trigger") {
+		return nil
+	}
+
+	subName := d.Get("name").(string)
+	quotedSubName := pq.QuoteIdentifier(subName)
+	enabled := d.Get("enabled").(bool)
+	copyData := d.Get("copy_data").(bool)
+
+	// Changing the publications refreshes them, unless the subscription is disabled.
+	refreshParams := fmt.Sprintf("WITH (refresh = %t)", enabled)
+	if enabled {
+		refreshParams = fmt.Sprintf("WITH (refresh = true, copy_data = %t)", copyData)
+	}
+
+	var queries []string
+	if d.HasChange("publications") {
+		oraw, nraw := d.GetChange("publications")
+		oldList := oraw.(*schema.Set).List()
+		newList := nraw.(*schema.Set).List()
+		added := arrayDifference(newList, oldList)
+		dropped := arrayDifference(oldList, newList)
+
+		if conn.featureSupported(featureSubscriptionAddDropPublication) && len(added) > 0 && len(dropped) == 0 {
+			queries = append(queries, fmt.Sprintf("ALTER SUBSCRIPTION %s ADD PUBLICATION %s %s", quotedSubName, quotePublicationList(added), refreshParams))
+		} else if conn.featureSupported(featureSubscriptionAddDropPublication) && len(added) == 0 && len(dropped) > 0 {
+			queries = append(queries, fmt.Sprintf("ALTER SUBSCRIPTION %s DROP PUBLICATION %s WITH (refresh = %t)", quotedSubName, quotePublicationList(dropped), enabled))
+		} else {
+			queries = append(queries, fmt.Sprintf("ALTER SUBSCRIPTION %s SET PUBLICATION %s %s", quotedSubName, quotePublicationList(newList), refreshParams))
+		}
+	} else {
+		if !enabled {
+			return fmt.Errorf("could not refresh publications of subscription %s: the subscription is disabled", subName)
+		}
+		queries = append(queries, fmt.Sprintf("ALTER SUBSCRIPTION %s REFRESH PUBLICATION WITH (copy_data = %t)", quotedSubName, copyData))
+	}
+
+	for _, query := range queries {
+		if _, err := conn.Exec(query); err != nil {
+			return fmt.Errorf("could not update publications of subscription %s: %w", subName, err)
+		}
+	}
+
+	return nil
+}
+
+func quotePublicationList(publications []any) string {
+	var plist []string
+	for _, p := range publications {
+		plist = append(plist, pq.QuoteIdentifier(p.(string)))
+	}
+	sort.Strings(plist)
+	return strings.Join(plist, ", ")
+}
+
+func setSubscriptionEnabled(conn *DBConnection, d *schema.ResourceData) error {
+	subName := d.Get("name").(string)
+
 	// Check if enabled has changed
 	if d.HasChange("enabled") {
 		oldEnabled, newEnabled := d.GetChange("enabled")
@@ -229,13 +518,6 @@ func resourcePostgreSQLSubscriptionUpdate(db *DBConnection, d *schema.ResourceDa
 		log.Printf("[INFO] Subscription %s: enabled change from %v (%T) to %v (%T), start_lsn: %q",
 			subName, oldEnabled, oldEnabled, newEnabled, newEnabled, startLSN)
 
-		// Subscription operations cannot be done in a transaction
-		client := db.client.config.NewClient(databaseName)
-		conn, err := client.Connect()
-		if err != nil {
-			return fmt.Errorf("could not establish database connection: %w", err)
-		}
-
 		if enabled {
 			// If switching from disabled to enabled and LSN is provided, handle LSN positioning
 			// Only proceed with LSN positioning if start_lsn is explicitly set to a valid LSN value
@@ -280,7 +562,7 @@ func resourcePostgreSQLSubscriptionUpdate(db *DBConnection, d *schema.ResourceDa
 			}
 		}
 	}
-	return resourcePostgreSQLSubscriptionReadImpl(db, d)
+	return nil
 }
 
 func resourcePostgreSQLSubscriptionDelete(db *DBConnection, d *schema.ResourceData) error {
@@ -424,11 +706,6 @@ func getOptionalParameters(d *schema.ResourceData) string {
 	copyData, okCopyData := d.GetOkExists("copy_data") //nolint:staticcheck
 	connect, okConnect := d.GetOkExists("connect")     //nolint:staticcheck
 
-	if !okCreate && !okName && !okEnabled && !okCopyData && !okConnect {
-		// use default behavior, no WITH statement
-		return ""
-	}
-
 	var params []string
 	if okCreate {
 		params = append(params, fmt.Sprintf("%s = %t", "create_slot", createSlot.(bool)))
@@ -445,11 +722,90 @@ func getOptionalParameters(d *schema.ResourceData) string {
 	if okConnect {
 		params = append(params, fmt.Sprintf("%s = %t", "connect", connect.(bool)))
 	}
+	for _, option := range subscriptionOptions {
+		if v, ok := d.GetOkExists(option.name); ok { //nolint:staticcheck
+			params = append(params, formatSubscriptionOption(option.name, v))
+		}
+	}
+
+	if len(params) == 0 {
+		// use default behavior, no WITH statement
+		return ""
+	}
 
 	returnValue = fmt.Sprintf(parameterSQLTemplate, strings.Join(params, ", "))
 	return returnValue
 }
 
+// getUpdatedOptionsParameters returns the updatable options which changed formatted for ALTER SUBSCRIPTION ... SET (...)
+func getUpdatedOptionsParameters(d *schema.ResourceData) string {
+	var params []string
+	for _, option := range subscriptionOptions {
+		if !option.updatable || !d.HasChange(option.name) {
+			continue
+		}
+		params = append(params, formatSubscriptionOption(option.name, d.Get(option.name)))
+	}
+	return strings.Join(params, ", ")
+}
+
+// subscriptionOptionsRequireDisabled returns true if an updated option can only be changed while the subscription is disabled.
+func subscriptionOptionsRequireDisabled(d *schema.ResourceData) bool {
+	for _, option := range subscriptionOptions {
+		if option.updatable && option.requiresDisabled && d.HasChange(option.name) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatSubscriptionOption(name string, value any) string {
+	switch v := value.(type) {
+	case bool:
+		return fmt.Sprintf("%s = %t", name, v)
+	default:
+		return fmt.Sprintf("%s = %s", name, pq.QuoteLiteral(fmt.Sprint(v)))
+	}
+}
+
+// readSubscriptionOption converts the value of an option read from pg_subscription (cast to text) to its attribute value.
+func readSubscriptionOption(name, value string) any {
+	switch name {
+	case "streaming":
+		// substream is a boolean before Postgres 16 and a char (f, t or p) since.
+		switch value {
+		case "true", "t":
+			return "on"
+		case "p":
+			return "parallel"
+		default:
+			return "off"
+		}
+	case "two_phase":
+		// subtwophasestate is d (disabled), p (pending enablement) or e (enabled).
+		return value != "d"
+	case "synchronous_commit", "origin":
+		return value
+	default:
+		return value == "true"
+	}
+}
+
+// validateSubscriptionOptions checks that the configured options are supported by the Postgres version.
+func validateSubscriptionOptions(db *DBConnection, d *schema.ResourceData) error {
+	for _, option := range subscriptionOptions {
+		if _, ok := d.GetOkExists(option.name); ok && !db.featureSupported(option.feature) { //nolint:staticcheck
+			return fmt.Errorf("%s attribute is supported only for postgres version %s and above", option.name, option.version)
+		}
+	}
+
+	if d.Get("streaming").(string) == "parallel" && !db.featureSupported(featureSubscriptionStreamingParallel) {
+		return fmt.Errorf("streaming = parallel is supported only for postgres version 16 and above")
+	}
+
+	return nil
+}
+
 func getSubscriptionNameFromID(ID string) string {
 	splitted := strings.Split(ID, ".")
 	return splitted[0]
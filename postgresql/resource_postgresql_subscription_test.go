@@ -7,7 +7,9 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func testAccCheckPostgresqlSubscriptionDestroy(s *terraform.State) error {
@@ -484,3 +486,280 @@ func TestAccPostgresqlSubscription_DisabledToEnabled(t *testing.T) {
 	})
 	coolDown()
 }
+
+func TestAccPostgresqlSubscription_Options(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffixPub, teardownPub := setupTestDatabase(t, true, true)
+	dbSuffixSub, teardownSub := setupTestDatabase(t, true, true)
+
+	defer teardownPub()
+	defer teardownSub()
+	testTables := []string{"test_schema.test_table_1"}
+	createTestTables(t, dbSuffixPub, testTables, "")
+	createTestTables(t, dbSuffixSub, testTables, "")
+
+	dbNamePub, _ := getTestDBNames(dbSuffixPub)
+	dbNameSub, _ := getTestDBNames(dbSuffixSub)
+
+	conninfo := getConnInfo(t, dbNamePub)
+
+	subName := "subscription_options_test"
+	testAccPostgresqlSubscriptionOptionsConfig := fmt.Sprintf(`
+	resource "postgresql_publication" "test_pub" {
+		name     	= "test_publication_options"
+		database	= "%s"
+		tables		= ["test_schema.test_table_1"]
+	}
+	resource "postgresql_replication_slot" "test_replication_slot" {
+		name		= "%s"
+		database	= "%s"
+		plugin		= "pgoutput"
+	}
+	resource "postgresql_subscription" "test_sub" {
+		name     			= postgresql_replication_slot.test_replication_slot.name
+		database 			= "%s"
+		conninfo 			= "%s"
+		publications		= [ postgresql_publication.test_pub.name ]
+		create_slot			= false
+		streaming			= "%%s"
+		binary				= %%t
+		disable_on_error	= true
+		synchronous_commit	= "%%s"
+		origin				= "%%s"
+	}
+	`, dbNamePub, subName, dbNamePub, dbNameSub, conninfo)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testSuperuserPreCheck(t)
+			testCheckCompatibleVersion(t, featureSubscriptionStreamingParallel)
+			testCheckCompatibleVersion(t, featureSubscriptionOrigin)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccPostgresqlSubscriptionOptionsConfig, "parallel", true, "local", "none"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlSubscriptionExists("postgresql_subscription.test_sub"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "streaming", "parallel"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "binary", "true"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "disable_on_error", "true"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "synchronous_commit", "local"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "origin", "none"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "two_phase", "false"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "password_required", "true"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "run_as_owner", "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccPostgresqlSubscriptionOptionsConfig, "off", false, "remote_apply", "any"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlSubscriptionExists("postgresql_subscription.test_sub"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "streaming", "off"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "binary", "false"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "synchronous_commit", "remote_apply"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "origin", "any"),
+				),
+			},
+		},
+	})
+	coolDown()
+}
+
+func TestAccPostgresqlSubscription_UpdateFailover(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffixPub, teardownPub := setupTestDatabase(t, true, true)
+	dbSuffixSub, teardownSub := setupTestDatabase(t, true, true)
+
+	defer teardownPub()
+	defer teardownSub()
+	testTables := []string{"test_schema.test_table_1"}
+	createTestTables(t, dbSuffixPub, testTables, "")
+	createTestTables(t, dbSuffixSub, testTables, "")
+
+	dbNamePub, _ := getTestDBNames(dbSuffixPub)
+	dbNameSub, _ := getTestDBNames(dbSuffixSub)
+
+	conninfo := getConnInfo(t, dbNamePub)
+
+	subName := "subscription_failover_test"
+	testAccPostgresqlSubscriptionFailoverConfig := fmt.Sprintf(`
+	resource "postgresql_publication" "test_pub" {
+		name     	= "test_publication_failover"
+		database	= "%s"
+		tables		= ["test_schema.test_table_1"]
+	}
+	resource "postgresql_replication_slot" "test_replication_slot" {
+		name		= "%s"
+		database	= "%s"
+		plugin		= "pgoutput"
+	}
+	resource "postgresql_subscription" "test_sub" {
+		name     			= postgresql_replication_slot.test_replication_slot.name
+		database 			= "%s"
+		conninfo 			= "%s"
+		publications		= [ postgresql_publication.test_pub.name ]
+		create_slot			= false
+		failover			= %%t
+	}
+	`, dbNamePub, subName, dbNamePub, dbNameSub, conninfo)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testSuperuserPreCheck(t)
+			testCheckCompatibleVersion(t, featureSubscriptionFailover)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccPostgresqlSubscriptionFailoverConfig, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlSubscriptionExists("postgresql_subscription.test_sub"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "enabled", "true"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "failover", "false"),
+				),
+			},
+			{
+				// failover can only be changed while the subscription is disabled,
+				// the subscription is disabled during the update and enabled again.
+				Config: fmt.Sprintf(testAccPostgresqlSubscriptionFailoverConfig, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlSubscriptionExists("postgresql_subscription.test_sub"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "enabled", "true"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "failover", "true"),
+				),
+			},
+		},
+	})
+	coolDown()
+}
+
+func TestSubscriptionOptionsRequireDisabled(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourcePostgreSQLSubscription().Schema, map[string]any{
+		"name":   "sub",
+		"binary": true,
+	})
+	assert.False(t, subscriptionOptionsRequireDisabled(d))
+
+	d = schema.TestResourceDataRaw(t, resourcePostgreSQLSubscription().Schema, map[string]any{
+		"name":     "sub",
+		"binary":   true,
+		"failover": true,
+	})
+	assert.True(t, subscriptionOptionsRequireDisabled(d))
+}
+
+func TestReadSubscriptionOption(t *testing.T) {
+	assert.Equal(t, "on", readSubscriptionOption("streaming", "true"))
+	assert.Equal(t, "off", readSubscriptionOption("streaming", "false"))
+	assert.Equal(t, "on", readSubscriptionOption("streaming", "t"))
+	assert.Equal(t, "off", readSubscriptionOption("streaming", "f"))
+	assert.Equal(t, "parallel", readSubscriptionOption("streaming", "p"))
+	assert.Equal(t, false, readSubscriptionOption("two_phase", "d"))
+	assert.Equal(t, true, readSubscriptionOption("two_phase", "p"))
+	assert.Equal(t, true, readSubscriptionOption("two_phase", "e"))
+	assert.Equal(t, "remote_apply", readSubscriptionOption("synchronous_commit", "remote_apply"))
+	assert.Equal(t, "none", readSubscriptionOption("origin", "none"))
+	assert.Equal(t, true, readSubscriptionOption("binary", "true"))
+	assert.Equal(t, false, readSubscriptionOption("failover", "false"))
+}
+
+func TestFormatSubscriptionOption(t *testing.T) {
+	assert.Equal(t, "binary = true", formatSubscriptionOption("binary", true))
+	assert.Equal(t, "streaming = 'parallel'", formatSubscriptionOption("streaming", "parallel"))
+	assert.Equal(t, "origin = 'none'", formatSubscriptionOption("origin", "none"))
+}
+
+func TestAccPostgresqlSubscription_UpdatePublications(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffixPub, teardownPub := setupTestDatabase(t, true, true)
+	dbSuffixSub, teardownSub := setupTestDatabase(t, true, true)
+
+	defer teardownPub()
+	defer teardownSub()
+	testTables := []string{"test_schema.test_table_1", "test_schema.test_table_2"}
+	createTestTables(t, dbSuffixPub, testTables, "")
+	createTestTables(t, dbSuffixSub, testTables, "")
+
+	dbNamePub, _ := getTestDBNames(dbSuffixPub)
+	dbNameSub, _ := getTestDBNames(dbSuffixSub)
+
+	conninfo := getConnInfo(t, dbNamePub)
+
+	subName := "subscription_publications_test"
+	testAccPostgresqlSubscriptionPublicationsConfig := fmt.Sprintf(`
+	resource "postgresql_publication" "test_pub_1" {
+		name     	= "test_publication_1"
+		database	= "%s"
+		tables		= ["test_schema.test_table_1"]
+	}
+	resource "postgresql_publication" "test_pub_2" {
+		name     	= "test_publication_2"
+		database	= "%s"
+		tables		= ["test_schema.test_table_2"]
+	}
+	resource "postgresql_replication_slot" "test_replication_slot" {
+		name		= "%s"
+		database	= "%s"
+		plugin		= "pgoutput"
+	}
+	resource "postgresql_subscription" "test_sub" {
+		name     		= postgresql_replication_slot.test_replication_slot.name
+		database 		= "%s"
+		conninfo 		= "%s"
+		publications	= [%%s]
+		create_slot		= false
+		refresh_trigger	= "%%s"
+	}
+	`, dbNamePub, dbNamePub, subName, dbNamePub, dbNameSub, conninfo)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testSuperuserPreCheck(t)
+			testCheckCompatibleVersion(t, featureSubscriptionAddDropPublication)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccPostgresqlSubscriptionPublicationsConfig, "postgresql_publication.test_pub_1.name", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlSubscriptionExists("postgresql_subscription.test_sub"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "publications.#", "1"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "table_sync_state.%", "1"),
+					resource.TestCheckResourceAttrSet("postgresql_subscription.test_sub", "table_sync_state.test_schema.test_table_1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(
+					testAccPostgresqlSubscriptionPublicationsConfig,
+					"postgresql_publication.test_pub_1.name, postgresql_publication.test_pub_2.name", "1",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlSubscriptionExists("postgresql_subscription.test_sub"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "publications.#", "2"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "table_sync_state.%", "2"),
+					resource.TestCheckResourceAttrSet("postgresql_subscription.test_sub", "table_sync_state.test_schema.test_table_2"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccPostgresqlSubscriptionPublicationsConfig, "postgresql_publication.test_pub_2.name", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlSubscriptionExists("postgresql_subscription.test_sub"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "publications.#", "1"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "publications.0", "test_publication_2"),
+					resource.TestCheckResourceAttr("postgresql_subscription.test_sub", "table_sync_state.%", "1"),
+				),
+			},
+		},
+	})
+	coolDown()
+}
@@ -0,0 +1,1074 @@
+package postgresql
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/lib/pq"
+)
+
+const (
+	tableNameAttr        = "name"
+	tableSchemaAttr      = "schema"
+	tableDatabaseAttr    = "database"
+	tableOwnerAttr       = "owner"
+	tableCommentAttr     = "comment"
+	tableColumnAttr      = "column"
+	tablePrimaryKeyAttr  = "primary_key"
+	tableUniqueAttr      = "unique_constraint"
+	tableCheckAttr       = "check_constraint"
+	tableDropCascadeAttr = "drop_cascade"
+
+	tableColumnDefaultDefinitionsAttr = "column_default_definitions"
+	tableCheckDefinitionsAttr         = "check_constraint_definitions"
+
+	tableColumnNameAttr    = "name"
+	tableColumnTypeAttr    = "type"
+	tableColumnDefaultAttr = "default"
+	tableColumnNotNullAttr = "not_null"
+
+	tableConstraintNameAttr       = "name"
+	tableConstraintColumnsAttr    = "columns"
+	tableConstraintExpressionAttr = "expression"
+)
+
+// tableColumnTypeAliases maps the type names accepted by PostgreSQL
+// to the canonical names returned by format_type().
+var tableColumnTypeAliases = map[string]string{
+	"int":         "integer",
+	"int4":        "integer",
+	"serial":      "integer",
+	"serial4":     "integer",
+	"int8":        "bigint",
+	"bigserial":   "bigint",
+	"serial8":     "bigint",
+	"int2":        "smallint",
+	"smallserial": "smallint",
+	"serial2":     "smallint",
+	"bool":        "boolean",
+	"float8":      "double precision",
+	"float":       "double precision",
+	"float4":      "real",
+	"decimal":     "numeric",
+	"varchar":     "character varying",
+	"char":        "character",
+	"timestamp":   "timestamp without time zone",
+	"timestamptz": "timestamp with time zone",
+	"time":        "time without time zone",
+	"timetz":      "time with time zone",
+	"varbit":      "bit varying",
+}
+
+func resourcePostgreSQLTable() *schema.Resource {
+	return &schema.Resource{
+		Create: PGResourceFunc(resourcePostgreSQLTableCreate),
+		Read:   PGResourceFunc(resourcePostgreSQLTableRead),
+		Update: PGResourceFunc(resourcePostgreSQLTableUpdate),
+		Delete: PGResourceFunc(resourcePostgreSQLTableDelete),
+		Exists: PGResourceExistsFunc(resourcePostgreSQLTableExists),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			tableNameAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the table",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			tableSchemaAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The schema where the table is located. Defaults to public",
+			},
+			tableDatabaseAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The database where the table is located. If not specified, the provider default database is used",
+			},
+			tableOwnerAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ROLE which owns the table",
+			},
+			tableCommentAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The comment of the table",
+			},
+			tableColumnAttr: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The columns of the table",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						tableColumnNameAttr: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The name of the column",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						tableColumnTypeAttr: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The data type of the column",
+
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return normalizeColumnType(old) == normalizeColumnType(new)
+							},
+						},
+						tableColumnDefaultAttr: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The default expression of the column",
+						},
+						tableColumnNotNullAttr: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "If true, the column cannot contain NULL values",
+						},
+					},
+				},
+			},
+			tablePrimaryKeyAttr: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The primary key of the table",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						tableConstraintNameAttr: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The name of the primary key constraint. Defaults to the name generated by PostgreSQL",
+						},
+						tableConstraintColumnsAttr: {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The columns of the primary key",
+						},
+					},
+				},
+			},
+			tableUniqueAttr: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The unique constraints of the table",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						tableConstraintNameAttr: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The name of the unique constraint",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						tableConstraintColumnsAttr: {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The columns of the unique constraint",
+						},
+					},
+				},
+			},
+			tableCheckAttr: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The check constraints of the table",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						tableConstraintNameAttr: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The name of the check constraint",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						tableConstraintExpressionAttr: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The boolean expression checked by the constraint",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+			tableDropCascadeAttr: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, will also drop all the objects that depend on the table (e.g.: views or foreign keys)",
+			},
+			tableColumnDefaultDefinitionsAttr: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The default expressions of the columns as stored by PostgreSQL",
+			},
+			tableCheckDefinitionsAttr: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The expressions of the check constraints as stored by PostgreSQL",
+			},
+		},
+	}
+}
+
+func resourcePostgreSQLTableCreate(db *DBConnection, d *schema.ResourceData) error {
+	database := getDatabase(d, db.client.databaseName)
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if err := createTable(txn, d); err != nil {
+		return err
+	}
+
+	if err := setTableOwner(txn, d); err != nil {
+		return err
+	}
+
+	if err := setTableComment(txn, d); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing table: %w", err)
+	}
+
+	d.SetId(generateTableID(d, database))
+
+	return resourcePostgreSQLTableReadImpl(db, d)
+}
+
+func createTable(txn *sql.Tx, d *schema.ResourceData) error {
+	tableName := d.Get(tableNameAttr).(string)
+
+	definitions := []string{}
+	for _, c := range d.Get(tableColumnAttr).([]any) {
+		definitions = append(definitions, tableColumnDefinition(c.(map[string]any)))
+	}
+
+	for _, pk := range d.Get(tablePrimaryKeyAttr).([]any) {
+		definitions = append(definitions, tablePrimaryKeyDefinition(pk.(map[string]any)))
+	}
+
+	for _, u := range d.Get(tableUniqueAttr).([]any) {
+		definitions = append(definitions, tableUniqueDefinition(u.(map[string]any)))
+	}
+
+	for _, c := range d.Get(tableCheckAttr).([]any) {
+		definitions = append(definitions, tableCheckDefinition(c.(map[string]any)))
+	}
+
+	b := bytes.NewBufferString("CREATE TABLE ")
+	fmt.Fprint(b, getTableQuotedName(d), " (\n    ")
+	fmt.Fprint(b, strings.Join(definitions, ",\n    "))
+	fmt.Fprint(b, "\n)")
+
+	if _, err := txn.Exec(b.String()); err != nil {
+		return fmt.Errorf("error creating table %s: %w", tableName, err)
+	}
+
+	return nil
+}
+
+func resourcePostgreSQLTableExists(db *DBConnection, d *schema.ResourceData) (bool, error) {
+	database, schemaName, tableName, err := getDBTableName(d, db.client.databaseName)
+	if err != nil {
+		return false, err
+	}
+
+	// Check if the database exists
+	exists, err := dbExists(db, database)
+	if err != nil || !exists {
+		return false, err
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return false, err
+	}
+	defer deferredRollback(txn)
+
+	return tableExists(txn, schemaName, tableName)
+}
+
+func resourcePostgreSQLTableRead(db *DBConnection, d *schema.ResourceData) error {
+	return resourcePostgreSQLTableReadImpl(db, d)
+}
+
+func resourcePostgreSQLTableReadImpl(db *DBConnection, d *schema.ResourceData) error {
+	database, schemaName, tableName, err := getDBTableName(d, db.client.databaseName)
+	if err != nil {
+		return err
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	var tableOID uint32
+	var tableOwner string
+	var tableComment sql.NullString
+
+	query := `SELECT c.oid, pg_catalog.pg_get_userbyid(c.relowner), pg_catalog.obj_description(c.oid, 'pg_class') ` +
+		`FROM pg_catalog.pg_class c ` +
+		`JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace ` +
+		`WHERE n.nspname = $1 AND c.relname = $2 AND c.relkind IN ('r', 'p')`
+	err = txn.QueryRow(query, schemaName, tableName).Scan(&tableOID, &tableOwner, &tableComment)
+	switch {
+	case err == sql.ErrNoRows:
+		log.Printf("[WARN] PostgreSQL table (%s.%s) not found in database %s", schemaName, tableName, database)
+		d.SetId("")
+		return nil
+	case err != nil:
+		return fmt.Errorf("error reading table: %w", err)
+	}
+
+	primaryKeys, uniques, checks, checkDefinitions, err := readTableConstraints(txn, d, tableOID)
+	if err != nil {
+		return err
+	}
+
+	columns, defaultDefinitions, err := readTableColumns(txn, d, tableOID, primaryKeys)
+	if err != nil {
+		return err
+	}
+
+	d.Set(tableNameAttr, tableName)
+	d.Set(tableSchemaAttr, schemaName)
+	d.Set(tableDatabaseAttr, database)
+	d.Set(tableOwnerAttr, tableOwner)
+	d.Set(tableCommentAttr, tableComment.String)
+	d.Set(tableColumnAttr, columns)
+	d.Set(tablePrimaryKeyAttr, primaryKeys)
+	d.Set(tableUniqueAttr, uniques)
+	d.Set(tableCheckAttr, checks)
+	d.Set(tableColumnDefaultDefinitionsAttr, defaultDefinitions)
+	d.Set(tableCheckDefinitionsAttr, checkDefinitions)
+	d.SetId(generateTableID(d, database))
+
+	return nil
+}
+
+// readTableColumns reads the columns definition from pg_attribute and returns the default expressions as stored by PostgreSQL.
+// The type configured is kept in the state as long as it is equivalent to the one stored by PostgreSQL,
+// which normalizes it (e.g.: int -> integer), and the default expression until it is changed outside of Terraform.
+func readTableColumns(txn *sql.Tx, d *schema.ResourceData, tableOID uint32, primaryKeys []any) ([]any, map[string]string, error) {
+	query := `SELECT a.attname, pg_catalog.format_type(a.atttypid, a.atttypmod), a.attnotnull, ` +
+		`COALESCE(pg_catalog.pg_get_expr(ad.adbin, ad.adrelid), '') ` +
+		`FROM pg_catalog.pg_attribute a ` +
+		`LEFT JOIN pg_catalog.pg_attrdef ad ON ad.adrelid = a.attrelid AND ad.adnum = a.attnum ` +
+		`WHERE a.attrelid = $1 AND a.attnum > 0 AND NOT a.attisdropped ` +
+		`ORDER BY a.attnum`
+
+	rows, err := txn.Query(query, tableOID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not read table columns: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("error closing rows: %v", err)
+		}
+	}()
+
+	stateColumns := tableItemsByName(d.Get(tableColumnAttr).([]any))
+	stateDefinitions := d.Get(tableColumnDefaultDefinitionsAttr).(map[string]any)
+
+	primaryKeyColumns := []string{}
+	for _, pk := range primaryKeys {
+		for _, c := range pk.(map[string]any)[tableConstraintColumnsAttr].([]any) {
+			primaryKeyColumns = append(primaryKeyColumns, c.(string))
+		}
+	}
+
+	columns := []map[string]any{}
+	definitions := map[string]string{}
+	for rows.Next() {
+		var name, columnType, columnDefault, stateDefault string
+		var notNull bool
+
+		if err := rows.Scan(&name, &columnType, &notNull, &columnDefault); err != nil {
+			return nil, nil, fmt.Errorf("could not scan table column: %w", err)
+		}
+
+		if stateColumn, ok := stateColumns[name]; ok {
+			stateType := stateColumn[tableColumnTypeAttr].(string)
+			stateDefault = stateColumn[tableColumnDefaultAttr].(string)
+
+			if normalizeColumnType(stateType) == normalizeColumnType(columnType) {
+				columnType = stateType
+			}
+
+			// Serial types generate their own default expression
+			if stateDefault == "" && isSerialColumnType(stateType) && strings.HasPrefix(columnDefault, "nextval(") {
+				columnDefault = ""
+			}
+
+			// A column of the primary key is implicitly NOT NULL.
+			if notNull && !stateColumn[tableColumnNotNullAttr].(bool) {
+				if sliceContainsStr(primaryKeyColumns, name) {
+					notNull = false
+				}
+			}
+		}
+
+		if columnDefault != "" {
+			definitions[name] = columnDefault
+		}
+
+		// PostgreSQL rewrites default expressions, the configured one is kept until it is changed outside of Terraform.
+		stateDefinition, _ := stateDefinitions[name].(string)
+
+		columns = append(columns, map[string]any{
+			tableColumnNameAttr:    name,
+			tableColumnTypeAttr:    columnType,
+			tableColumnDefaultAttr: resolveStateExpression(stateDefault, stateDefinition, columnDefault),
+			tableColumnNotNullAttr: notNull,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("got rows.Err: %w", err)
+	}
+
+	return sortTableItemsLikeState(d.Get(tableColumnAttr).([]any), columns), definitions, nil
+}
+
+// readTableConstraints reads the primary key, unique and check constraints from pg_constraint
+// and returns the expressions of the check constraints as stored by PostgreSQL.
+func readTableConstraints(txn *sql.Tx, d *schema.ResourceData, tableOID uint32) (primaryKeys, uniques, checks []any, checkDefinitions map[string]string, err error) {
+	query := `SELECT con.conname, con.contype, ` +
+		`ARRAY(SELECT a.attname FROM unnest(con.conkey) WITH ORDINALITY AS k(attnum, ord) ` +
+		`JOIN pg_catalog.pg_attribute a ON a.attrelid = con.conrelid AND a.attnum = k.attnum ORDER BY k.ord)::TEXT[], ` +
+		`pg_catalog.pg_get_constraintdef(con.oid) ` +
+		`FROM pg_catalog.pg_constraint con ` +
+		`WHERE con.conrelid = $1 AND con.contype IN ('p', 'u', 'c') ` +
+		`ORDER BY con.conname`
+
+	rows, err := txn.Query(query, tableOID)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("could not read table constraints: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("error closing rows: %v", err)
+		}
+	}()
+
+	stateChecks := tableItemsByName(d.Get(tableCheckAttr).([]any))
+	stateDefinitions := d.Get(tableCheckDefinitionsAttr).(map[string]any)
+	checkDefinitions = map[string]string{}
+
+	var primaryKeyList, uniqueList, checkList []map[string]any
+	for rows.Next() {
+		var name, constraintType, definition string
+		var columnNames []string
+
+		if err := rows.Scan(&name, &constraintType, pq.Array(&columnNames), &definition); err != nil {
+			return nil, nil, nil, nil, fmt.Errorf("could not scan table constraint: %w", err)
+		}
+
+		columns := make([]any, len(columnNames))
+		for i, c := range columnNames {
+			columns[i] = c
+		}
+
+		switch constraintType {
+		case "p":
+			primaryKeyList = append(primaryKeyList, map[string]any{
+				tableConstraintNameAttr:    name,
+				tableConstraintColumnsAttr: columns,
+			})
+		case "u":
+			uniqueList = append(uniqueList, map[string]any{
+				tableConstraintNameAttr:    name,
+				tableConstraintColumnsAttr: columns,
+			})
+		case "c":
+			expression := parseCheckConstraintDef(definition)
+			checkDefinitions[name] = expression
+
+			// PostgreSQL rewrites the check expressions, the configured one is kept until it is changed outside of Terraform.
+			var stateExpression string
+			if stateCheck, ok := stateChecks[name]; ok {
+				stateExpression = stateCheck[tableConstraintExpressionAttr].(string)
+			}
+			stateDefinition, _ := stateDefinitions[name].(string)
+
+			checkList = append(checkList, map[string]any{
+				tableConstraintNameAttr:       name,
+				tableConstraintExpressionAttr: resolveStateExpression(stateExpression, stateDefinition, expression),
+			})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("got rows.Err: %w", err)
+	}
+
+	primaryKeys = make([]any, 0, len(primaryKeyList))
+	for _, pk := range primaryKeyList {
+		primaryKeys = append(primaryKeys, pk)
+	}
+
+	return primaryKeys,
+		sortTableItemsLikeState(d.Get(tableUniqueAttr).([]any), uniqueList),
+		sortTableItemsLikeState(d.Get(tableCheckAttr).([]any), checkList),
+		checkDefinitions,
+		nil
+}
+
+func resourcePostgreSQLTableUpdate(db *DBConnection, d *schema.ResourceData) error {
+	database := getDatabase(d, db.client.databaseName)
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if err := setTableName(txn, d, database); err != nil {
+		return err
+	}
+
+	// Constraints are dropped before altering the columns as they can reference dropped columns
+	// and they are added back once all the columns exist.
+	if err := dropTableConstraints(txn, d); err != nil {
+		return err
+	}
+
+	if err := setTableColumns(txn, d); err != nil {
+		return err
+	}
+
+	if err := addTableConstraints(txn, d); err != nil {
+		return err
+	}
+
+	if err := setTableOwner(txn, d); err != nil {
+		return err
+	}
+
+	if err := setTableComment(txn, d); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing table: %w", err)
+	}
+
+	return resourcePostgreSQLTableReadImpl(db, d)
+}
+
+func setTableName(txn *sql.Tx, d *schema.ResourceData, databaseName string) error {
+	if !d.HasChange(tableNameAttr) {
+		return nil
+	}
+
+	oraw, nraw := d.GetChange(tableNameAttr)
+	o := oraw.(string)
+	n := nraw.(string)
+	if n == "" {
+		return errors.New("error setting table name to an empty string")
+	}
+
+	schemaName := d.Get(tableSchemaAttr).(string)
+	sql := fmt.Sprintf(
+		"ALTER TABLE %s.%s RENAME TO %s",
+		pq.QuoteIdentifier(schemaName), pq.QuoteIdentifier(o), pq.QuoteIdentifier(n),
+	)
+	if _, err := txn.Exec(sql); err != nil {
+		return fmt.Errorf("error updating table NAME: %w", err)
+	}
+	d.SetId(generateTableID(d, databaseName))
+
+	return nil
+}
+
+func setTableOwner(txn *sql.Tx, d *schema.ResourceData) error {
+	if !d.HasChange(tableOwnerAttr) {
+		return nil
+	}
+
+	tableOwner := d.Get(tableOwnerAttr).(string)
+	if tableOwner == "" {
+		return nil
+	}
+
+	// If the authenticated user is not a superuser (e.g. on AWS RDS)
+	// it needs to be a member of the new owner.
+	return withRolesGranted(txn, []string{tableOwner}, func() error {
+		sql := fmt.Sprintf("ALTER TABLE %s OWNER TO %s", getTableQuotedName(d), pq.QuoteIdentifier(tableOwner))
+		if _, err := txn.Exec(sql); err != nil {
+			return fmt.Errorf("error updating table OWNER: %w", err)
+		}
+		return nil
+	})
+}
+
+func setTableComment(txn *sql.Tx, d *schema.ResourceData) error {
+	if !d.HasChange(tableCommentAttr) {
+		return nil
+	}
+
+	comment := "NULL"
+	if v := d.Get(tableCommentAttr).(string); v != "" {
+		comment = pq.QuoteLiteral(v)
+	}
+
+	sql := fmt.Sprintf("COMMENT ON TABLE %s IS %s", getTableQuotedName(d), comment)
+	if _, err := txn.Exec(sql); err != nil {
+		return fmt.Errorf("error updating table COMMENT: %w", err)
+	}
+
+	return nil
+}
+
+// setTableColumns adds, drops or alters the columns which have changed.
+// Columns are matched by name, so renaming a column drops it and adds a new one.
+func setTableColumns(txn *sql.Tx, d *schema.ResourceData) error {
+	if !d.HasChange(tableColumnAttr) {
+		return nil
+	}
+
+	oraw, nraw := d.GetChange(tableColumnAttr)
+	oldColumns := tableItemsByName(oraw.([]any))
+	newColumns := tableItemsByName(nraw.([]any))
+
+	tableName := getTableQuotedName(d)
+	queries := []string{}
+	definitions := d.Get(tableColumnDefaultDefinitionsAttr).(map[string]any)
+
+	for _, c := range oraw.([]any) {
+		column := c.(map[string]any)
+		name := column[tableColumnNameAttr].(string)
+		if _, ok := newColumns[name]; !ok {
+			queries = append(queries, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", tableName, pq.QuoteIdentifier(name)))
+		}
+	}
+
+	for _, c := range nraw.([]any) {
+		column := c.(map[string]any)
+		name := column[tableColumnNameAttr].(string)
+
+		oldColumn, ok := oldColumns[name]
+		if !ok {
+			queries = append(queries, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", tableName, tableColumnDefinition(column)))
+			delete(definitions, name)
+			continue
+		}
+
+		alterColumn := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s", tableName, pq.QuoteIdentifier(name))
+
+		newType := column[tableColumnTypeAttr].(string)
+		if normalizeColumnType(oldColumn[tableColumnTypeAttr].(string)) != normalizeColumnType(newType) {
+			queries = append(queries, fmt.Sprintf("%s TYPE %s", alterColumn, newType))
+			delete(definitions, name)
+		}
+
+		newDefault := column[tableColumnDefaultAttr].(string)
+		if oldColumn[tableColumnDefaultAttr].(string) != newDefault {
+			delete(definitions, name)
+			if newDefault == "" {
+				queries = append(queries, fmt.Sprintf("%s DROP DEFAULT", alterColumn))
+			} else {
+				queries = append(queries, fmt.Sprintf("%s SET DEFAULT %s", alterColumn, newDefault))
+			}
+		}
+
+		newNotNull := column[tableColumnNotNullAttr].(bool)
+		if oldColumn[tableColumnNotNullAttr].(bool) != newNotNull {
+			if newNotNull {
+				queries = append(queries, fmt.Sprintf("%s SET NOT NULL", alterColumn))
+			} else {
+				queries = append(queries, fmt.Sprintf("%s DROP NOT NULL", alterColumn))
+			}
+		}
+	}
+
+	for _, query := range queries {
+		if _, err := txn.Exec(query); err != nil {
+			return fmt.Errorf("error updating table columns: %w", err)
+		}
+	}
+
+	// Reset the definitions of the changed defaults so they are read back from the database.
+	d.Set(tableColumnDefaultDefinitionsAttr, definitions)
+
+	return nil
+}
+
+func dropTableConstraints(txn *sql.Tx, d *schema.ResourceData) error {
+	tableName := getTableQuotedName(d)
+
+	for _, attr := range []string{tablePrimaryKeyAttr, tableUniqueAttr, tableCheckAttr} {
+		if !d.HasChange(attr) {
+			continue
+		}
+
+		dropped, _ := tableChangedConstraints(d, attr)
+		for _, name := range dropped {
+			sql := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT IF EXISTS %s", tableName, pq.QuoteIdentifier(name))
+			if _, err := txn.Exec(sql); err != nil {
+				return fmt.Errorf("error dropping table constraint %s: %w", name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func addTableConstraints(txn *sql.Tx, d *schema.ResourceData) error {
+	tableName := getTableQuotedName(d)
+
+	definitionFuncs := map[string]func(map[string]any) string{
+		tablePrimaryKeyAttr: tablePrimaryKeyDefinition,
+		tableUniqueAttr:     tableUniqueDefinition,
+		tableCheckAttr:      tableCheckDefinition,
+	}
+
+	checkDefinitions := d.Get(tableCheckDefinitionsAttr).(map[string]any)
+
+	for _, attr := range []string{tablePrimaryKeyAttr, tableUniqueAttr, tableCheckAttr} {
+		if !d.HasChange(attr) {
+			continue
+		}
+
+		_, added := tableChangedConstraints(d, attr)
+		for _, constraint := range added {
+			sql := fmt.Sprintf("ALTER TABLE %s ADD %s", tableName, definitionFuncs[attr](constraint))
+			if _, err := txn.Exec(sql); err != nil {
+				return fmt.Errorf("error adding table constraint: %w", err)
+			}
+			if attr == tableCheckAttr {
+				delete(checkDefinitions, constraint[tableConstraintNameAttr].(string))
+			}
+		}
+	}
+
+	// Reset the definitions of the added checks so their expressions are read back from the database.
+	d.Set(tableCheckDefinitionsAttr, checkDefinitions)
+
+	return nil
+}
+
+// tableChangedConstraints returns the names of the constraints to drop and the constraints to add
+// for the specified attribute. A modified constraint is dropped and added back.
+func tableChangedConstraints(d *schema.ResourceData, attr string) (dropped []string, added []map[string]any) {
+	oraw, nraw := d.GetChange(attr)
+	oldList := oraw.([]any)
+	newList := nraw.([]any)
+
+	// The primary key name is computed, so we compare only the columns of the old and new one.
+	if attr == tablePrimaryKeyAttr {
+		var oldPK, newPK map[string]any
+		if len(oldList) > 0 {
+			oldPK = oldList[0].(map[string]any)
+		}
+		if len(newList) > 0 {
+			newPK = newList[0].(map[string]any)
+		}
+
+		switch {
+		case oldPK == nil && newPK == nil:
+			return nil, nil
+		case oldPK != nil && newPK != nil &&
+			reflect.DeepEqual(oldPK[tableConstraintColumnsAttr], newPK[tableConstraintColumnsAttr]) &&
+			(newPK[tableConstraintNameAttr] == "" || newPK[tableConstraintNameAttr] == oldPK[tableConstraintNameAttr]):
+			return nil, nil
+		}
+
+		if oldPK != nil {
+			dropped = append(dropped, oldPK[tableConstraintNameAttr].(string))
+		}
+		if newPK != nil {
+			added = append(added, newPK)
+		}
+		return dropped, added
+	}
+
+	oldConstraints := tableItemsByName(oldList)
+	newConstraints := tableItemsByName(newList)
+
+	for name, oldConstraint := range oldConstraints {
+		if newConstraint, ok := newConstraints[name]; !ok || !reflect.DeepEqual(oldConstraint, newConstraint) {
+			dropped = append(dropped, name)
+		}
+	}
+
+	for _, c := range newList {
+		newConstraint := c.(map[string]any)
+		name := newConstraint[tableConstraintNameAttr].(string)
+		if oldConstraint, ok := oldConstraints[name]; !ok || !reflect.DeepEqual(oldConstraint, newConstraint) {
+			added = append(added, newConstraint)
+		}
+	}
+
+	return dropped, added
+}
+
+func resourcePostgreSQLTableDelete(db *DBConnection, d *schema.ResourceData) error {
+	database := getDatabase(d, db.client.databaseName)
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	exists, err := tableExists(txn, d.Get(tableSchemaAttr).(string), d.Get(tableNameAttr).(string))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	owner := d.Get(tableOwnerAttr).(string)
+
+	if err := withRolesGranted(txn, []string{owner}, func() error {
+		dropMode := "RESTRICT"
+		if d.Get(tableDropCascadeAttr).(bool) {
+			dropMode = "CASCADE"
+		}
+
+		sql := fmt.Sprintf("DROP TABLE %s %s", getTableQuotedName(d), dropMode)
+		if _, err := txn.Exec(sql); err != nil {
+			return fmt.Errorf("error deleting table: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing table: %w", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func tableColumnDefinition(column map[string]any) string {
+	b := bytes.NewBufferString(pq.QuoteIdentifier(column[tableColumnNameAttr].(string)))
+	fmt.Fprint(b, " ", column[tableColumnTypeAttr].(string))
+
+	if v := column[tableColumnDefaultAttr].(string); v != "" {
+		fmt.Fprint(b, " DEFAULT ", v)
+	}
+
+	if column[tableColumnNotNullAttr].(bool) {
+		fmt.Fprint(b, " NOT NULL")
+	}
+
+	return b.String()
+}
+
+func tablePrimaryKeyDefinition(pk map[string]any) string {
+	definition := fmt.Sprintf("PRIMARY KEY (%s)", tableQuotedColumns(pk[tableConstraintColumnsAttr]))
+	if name, _ := pk[tableConstraintNameAttr].(string); name != "" {
+		definition = fmt.Sprintf("CONSTRAINT %s %s", pq.QuoteIdentifier(name), definition)
+	}
+	return definition
+}
+
+func tableUniqueDefinition(unique map[string]any) string {
+	return fmt.Sprintf(
+		"CONSTRAINT %s UNIQUE (%s)",
+		pq.QuoteIdentifier(unique[tableConstraintNameAttr].(string)),
+		tableQuotedColumns(unique[tableConstraintColumnsAttr]),
+	)
+}
+
+func tableCheckDefinition(check map[string]any) string {
+	return fmt.Sprintf(
+		"CONSTRAINT %s CHECK (%s)",
+		pq.QuoteIdentifier(check[tableConstraintNameAttr].(string)),
+		check[tableConstraintExpressionAttr].(string),
+	)
+}
+
+func tableQuotedColumns(columns any) string {
+	quoted := []string{}
+	for _, c := range columns.([]any) {
+		quoted = append(quoted, pq.QuoteIdentifier(c.(string)))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// parseCheckConstraintDef extracts the expression from the output of pg_get_constraintdef,
+// e.g.: CHECK ((price > (0)::numeric)) -> (price > (0)::numeric)
+func parseCheckConstraintDef(definition string) string {
+	definition = strings.TrimSuffix(definition, " NOT VALID")
+	definition = strings.TrimSuffix(definition, " NO INHERIT")
+	definition = strings.TrimPrefix(definition, "CHECK ")
+	if strings.HasPrefix(definition, "(") && strings.HasSuffix(definition, ")") {
+		definition = definition[1 : len(definition)-1]
+	}
+	return definition
+}
+
+var columnTypeSpaceRe = regexp.MustCompile(`\s+`)
+
+// normalizeColumnType returns the canonical name of a column type so it can be compared
+// with the type returned by format_type(), e.g.: VARCHAR(20) -> character varying(20)
+func normalizeColumnType(columnType string) string {
+	columnType = strings.ToLower(strings.TrimSpace(columnType))
+	columnType = columnTypeSpaceRe.ReplaceAllString(columnType, " ")
+	columnType = strings.ReplaceAll(columnType, " (", "(")
+
+	base, modifiers := columnType, ""
+	if idx := strings.IndexAny(columnType, "(["); idx >= 0 {
+		base, modifiers = columnType[:idx], columnType[idx:]
+	}
+
+	if alias, ok := tableColumnTypeAliases[base]; ok {
+		base = alias
+	}
+
+	// timestamp(3) is rendered as timestamp(3) without time zone
+	for _, t := range []string{"timestamp", "time"} {
+		if base == t+" without time zone" && strings.HasPrefix(modifiers, "(") {
+			if idx := strings.Index(modifiers, ")"); idx >= 0 {
+				return t + modifiers[:idx+1] + " without time zone" + modifiers[idx+1:]
+			}
+		}
+	}
+
+	return base + modifiers
+}
+
+func isSerialColumnType(columnType string) bool {
+	return sliceContainsStr(
+		[]string{"serial", "serial4", "bigserial", "serial8", "smallserial", "serial2"},
+		strings.ToLower(strings.TrimSpace(columnType)),
+	)
+}
+
+// tableItemsByName indexes a list of nested blocks (columns or constraints) by their name.
+func tableItemsByName(items []any) map[string]map[string]any {
+	result := make(map[string]map[string]any, len(items))
+	for _, item := range items {
+		m := item.(map[string]any)
+		result[m[tableColumnNameAttr].(string)] = m
+	}
+	return result
+}
+
+// sortTableItemsLikeState sorts the items read from the database in the same order as in the state
+// so reordering blocks in the configuration does not generate a diff. Unknown items are appended at the end.
+func sortTableItemsLikeState(state []any, items []map[string]any) []any {
+	result := make([]any, 0, len(items))
+	added := make(map[string]bool, len(items))
+	byName := make(map[string]map[string]any, len(items))
+	for _, item := range items {
+		byName[item[tableColumnNameAttr].(string)] = item
+	}
+
+	for _, s := range state {
+		name := s.(map[string]any)[tableColumnNameAttr].(string)
+		if item, ok := byName[name]; ok && !added[name] {
+			result = append(result, item)
+			added[name] = true
+		}
+	}
+
+	for _, item := range items {
+		name := item[tableColumnNameAttr].(string)
+		if !added[name] {
+			result = append(result, item)
+			added[name] = true
+		}
+	}
+
+	return result
+}
+
+func tableExists(txn *sql.Tx, schemaName, tableName string) (bool, error) {
+	var _rez bool
+	query := `SELECT TRUE FROM pg_catalog.pg_class c ` +
+		`JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace ` +
+		`WHERE n.nspname = $1 AND c.relname = $2 AND c.relkind IN ('r', 'p')`
+	err := txn.QueryRow(query, schemaName, tableName).Scan(&_rez)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("could not check if table exists: %w", err)
+	}
+
+	return true, nil
+}
+
+func getTableQuotedName(d *schema.ResourceData) string {
+	schemaName := "public"
+	if v, ok := d.GetOk(tableSchemaAttr); ok {
+		schemaName = v.(string)
+	}
+	return fmt.Sprintf("%s.%s", pq.QuoteIdentifier(schemaName), pq.QuoteIdentifier(d.Get(tableNameAttr).(string)))
+}
+
+func generateTableID(d *schema.ResourceData, databaseName string) string {
+	schemaName := "public"
+	if v, ok := d.GetOk(tableSchemaAttr); ok {
+		schemaName = v.(string)
+	}
+
+	return strings.Join([]string{
+		getDatabase(d, databaseName),
+		schemaName,
+		d.Get(tableNameAttr).(string),
+	}, ".")
+}
+
+// getDBTableName returns database, schema and table name. If we are importing this
+// resource, they will be parsed from the resource ID (it will return an error if parsing failed)
+// otherwise they will be simply get from the state.
+func getDBTableName(d *schema.ResourceData, databaseName string) (string, string, string, error) {
+	database := getDatabase(d, databaseName)
+	schemaName := "public"
+	if v, ok := d.GetOk(tableSchemaAttr); ok {
+		schemaName = v.(string)
+	}
+	tableName := d.Get(tableNameAttr).(string)
+
+	// When importing, we have to parse the ID to find database, schema and table names.
+	if tableName == "" {
+		parsed := strings.Split(d.Id(), ".")
+		if len(parsed) != 3 {
+			return "", "", "", fmt.Errorf("table ID %s has not the expected format 'database.schema.table': %v", d.Id(), parsed)
+		}
+		database = parsed[0]
+		schemaName = parsed[1]
+		tableName = parsed[2]
+	}
+	return database, schemaName, tableName, nil
+}
@@ -0,0 +1,321 @@
+package postgresql
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeColumnType(t *testing.T) {
+	cases := []struct {
+		columnType string
+		expected   string
+	}{
+		{columnType: "int", expected: "integer"},
+		{columnType: "INTEGER", expected: "integer"},
+		{columnType: "bigserial", expected: "bigint"},
+		{columnType: "VARCHAR(20)", expected: "character varying(20)"},
+		{columnType: "varchar (20)", expected: "character varying(20)"},
+		{columnType: "text[]", expected: "text[]"},
+		{columnType: "int[]", expected: "integer[]"},
+		{columnType: "timestamptz", expected: "timestamp with time zone"},
+		{columnType: "timestamp(3)", expected: "timestamp(3) without time zone"},
+		{columnType: "numeric(10,2)", expected: "numeric(10,2)"},
+		{columnType: "double  precision", expected: "double precision"},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expected, normalizeColumnType(c.columnType), c.columnType)
+	}
+}
+
+func TestParseCheckConstraintDef(t *testing.T) {
+	cases := []struct {
+		definition string
+		expected   string
+	}{
+		{definition: "CHECK ((price > 0))", expected: "(price > 0)"},
+		{definition: "CHECK ((price > (0)::numeric)) NOT VALID", expected: "(price > (0)::numeric)"},
+		{definition: "CHECK (enabled)", expected: "enabled"},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expected, parseCheckConstraintDef(c.definition))
+	}
+}
+
+func TestAccPostgresqlTable_Basic(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	dbName, roleName := getTestDBNames(dbSuffix)
+
+	config := fmt.Sprintf(testAccPostgresqlTableConfig, dbName, roleName)
+	testConfig := getTestConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlTableExists("postgresql_table.test"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "name", "orders"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "schema", "public"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "database", dbName),
+					resource.TestCheckResourceAttr("postgresql_table.test", "owner", roleName),
+					resource.TestCheckResourceAttr("postgresql_table.test", "comment", "orders table"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "column.#", "3"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "column.0.name", "id"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "column.0.type", "bigserial"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "column.1.name", "reference"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "column.1.not_null", "true"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "column.2.default", "0"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "primary_key.0.name", "orders_pkey"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "primary_key.0.columns.0", "id"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "unique_constraint.0.name", "orders_reference_key"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "check_constraint.0.name", "orders_amount_check"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "check_constraint.0.expression", "amount >= 0"),
+				),
+			},
+			{
+				// The expressions changed outside of Terraform are not hidden by the configured ones
+				PreConfig: func() {
+					dbExecute(t, testConfig.connStr(dbName), "ALTER TABLE orders ALTER COLUMN amount SET DEFAULT 1")
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_table.test", "column.2.default", "0"),
+				),
+			},
+			{
+				PreConfig: func() {
+					dbExecute(t, testConfig.connStr(dbName),
+						"ALTER TABLE orders DROP CONSTRAINT orders_amount_check, ADD CONSTRAINT orders_amount_check CHECK (amount >= 1)")
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlTable_Update(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	dbName, roleName := getTestDBNames(dbSuffix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccPostgresqlTableConfig, dbName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlTableExists("postgresql_table.test"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccPostgresqlTableConfigUpdated, dbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlTableExists("postgresql_table.test"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "name", "purchases"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "comment", ""),
+					resource.TestCheckResourceAttr("postgresql_table.test", "column.#", "3"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "column.1.name", "reference"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "column.1.type", "text"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "column.1.not_null", "false"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "column.2.name", "created_at"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "column.2.default", "now()"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "unique_constraint.#", "0"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "check_constraint.#", "1"),
+					resource.TestCheckResourceAttr("postgresql_table.test", "check_constraint.0.name", "purchases_reference_check"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlTable_Import(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	dbName, roleName := getTestDBNames(dbSuffix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccPostgresqlTableConfig, dbName, roleName),
+			},
+			{
+				ResourceName:            "postgresql_table.test",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s.public.orders", dbName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"column", "check_constraint", "drop_cascade"},
+			},
+		},
+	})
+}
+
+func testAccCheckPostgresqlTableDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "postgresql_table" {
+			continue
+		}
+
+		exists, err := checkTableExists(client, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking table %s", err)
+		}
+
+		if exists {
+			return fmt.Errorf("Table still exists after destroy")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckPostgresqlTableExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Client)
+		exists, err := checkTableExists(client, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking table %s", err)
+		}
+
+		if !exists {
+			return fmt.Errorf("Table not found")
+		}
+
+		return nil
+	}
+}
+
+func checkTableExists(client *Client, tableID string) (bool, error) {
+	parts := strings.Split(tableID, ".")
+	if len(parts) != 3 {
+		return false, fmt.Errorf("unexpected table ID %s", tableID)
+	}
+
+	txn, err := startTransaction(client, parts[0])
+	if err != nil {
+		return false, err
+	}
+	defer deferredRollback(txn)
+
+	return tableExists(txn, parts[1], parts[2])
+}
+
+const testAccPostgresqlTableConfig = `
+resource "postgresql_table" "test" {
+  database = "%s"
+  name     = "orders"
+  owner    = "%s"
+  comment  = "orders table"
+
+  column {
+    name     = "id"
+    type     = "bigserial"
+  }
+
+  column {
+    name     = "reference"
+    type     = "varchar(32)"
+    not_null = true
+  }
+
+  column {
+    name    = "amount"
+    type    = "numeric(10,2)"
+    default = "0"
+  }
+
+  primary_key {
+    columns = ["id"]
+  }
+
+  unique_constraint {
+    name    = "orders_reference_key"
+    columns = ["reference"]
+  }
+
+  check_constraint {
+    name       = "orders_amount_check"
+    expression = "amount >= 0"
+  }
+}
+`
+
+const testAccPostgresqlTableConfigUpdated = `
+resource "postgresql_table" "test" {
+  database = "%s"
+  name     = "purchases"
+
+  column {
+    name     = "id"
+    type     = "bigserial"
+  }
+
+  column {
+    name = "reference"
+    type = "text"
+  }
+
+  column {
+    name    = "created_at"
+    type    = "timestamptz"
+    default = "now()"
+  }
+
+  primary_key {
+    columns = ["id"]
+  }
+
+  check_constraint {
+    name       = "purchases_reference_check"
+    expression = "reference <> ''"
+  }
+}
+`
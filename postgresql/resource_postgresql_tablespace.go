@@ -0,0 +1,252 @@
+package postgresql
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/lib/pq"
+)
+
+const (
+	tablespaceNameAttr     = "name"
+	tablespaceLocationAttr = "location"
+	tablespaceOwnerAttr    = "owner"
+	tablespaceOptionsAttr  = "options"
+)
+
+// allowedTablespaceOptions is the list of options which can be set on a tablespace.
+// see: https://www.postgresql.org/docs/current/sql-createtablespace.html
+var allowedTablespaceOptions = []string{
+	"seq_page_cost",
+	"random_page_cost",
+	"effective_io_concurrency",
+	"maintenance_io_concurrency",
+}
+
+var tablespaceOptionsRegexp = regexp.MustCompile("^(" + strings.Join(allowedTablespaceOptions, "|") + ")$")
+
+func resourcePostgreSQLTablespace() *schema.Resource {
+	return &schema.Resource{
+		Create: PGResourceFunc(resourcePostgreSQLTablespaceCreate),
+		Read:   PGResourceFunc(resourcePostgreSQLTablespaceRead),
+		Update: PGResourceFunc(resourcePostgreSQLTablespaceUpdate),
+		Delete: PGResourceFunc(resourcePostgreSQLTablespaceDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			tablespaceNameAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the tablespace",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			tablespaceLocationAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The directory that will be used for the tablespace",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			tablespaceOwnerAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The role which owns the tablespace",
+			},
+			tablespaceOptionsAttr: {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				Description:      "Tablespace parameters (seq_page_cost, random_page_cost, effective_io_concurrency or maintenance_io_concurrency)",
+				ValidateDiagFunc: validation.MapKeyMatch(tablespaceOptionsRegexp, "unknown tablespace option"),
+			},
+		},
+	}
+}
+
+func resourcePostgreSQLTablespaceCreate(db *DBConnection, d *schema.ResourceData) error {
+	name := d.Get(tablespaceNameAttr).(string)
+
+	b := bytes.NewBufferString("CREATE TABLESPACE ")
+	fmt.Fprint(b, pq.QuoteIdentifier(name))
+
+	if v, ok := d.GetOk(tablespaceOwnerAttr); ok {
+		fmt.Fprint(b, " OWNER ", pq.QuoteIdentifier(v.(string)))
+	}
+
+	fmt.Fprint(b, " LOCATION ", pq.QuoteLiteral(d.Get(tablespaceLocationAttr).(string)))
+
+	if v, ok := d.GetOk(tablespaceOptionsAttr); ok {
+		fmt.Fprint(b, " WITH (", strings.Join(tablespaceOptionsList(v.(map[string]any)), ", "), ")")
+	}
+
+	// CREATE TABLESPACE cannot be executed inside a transaction block.
+	sql := b.String()
+	if _, err := db.Exec(sql); err != nil {
+		return fmt.Errorf("error creating tablespace %s: %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourcePostgreSQLTablespaceReadImpl(db, d)
+}
+
+func resourcePostgreSQLTablespaceRead(db *DBConnection, d *schema.ResourceData) error {
+	return resourcePostgreSQLTablespaceReadImpl(db, d)
+}
+
+func resourcePostgreSQLTablespaceReadImpl(db *DBConnection, d *schema.ResourceData) error {
+	name := d.Id()
+
+	var owner, location string
+	var options pq.ByteaArray
+	query := "SELECT pg_catalog.pg_get_userbyid(spcowner), pg_catalog.pg_tablespace_location(oid), spcoptions " +
+		"FROM pg_catalog.pg_tablespace WHERE spcname = $1"
+	err := db.QueryRow(query, name).Scan(&owner, &location, &options)
+	switch {
+	case err == sql.ErrNoRows:
+		log.Printf("[WARN] PostgreSQL tablespace (%s) not found", name)
+		d.SetId("")
+		return nil
+	case err != nil:
+		return fmt.Errorf("error reading tablespace %s: %w", name, err)
+	}
+
+	d.Set(tablespaceNameAttr, name)
+	d.Set(tablespaceOwnerAttr, owner)
+	d.Set(tablespaceLocationAttr, location)
+	d.Set(tablespaceOptionsAttr, readParameters(options))
+
+	return nil
+}
+
+func resourcePostgreSQLTablespaceUpdate(db *DBConnection, d *schema.ResourceData) error {
+	txn, err := startTransaction(db.client, "")
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if err := setTablespaceName(txn, d); err != nil {
+		return err
+	}
+
+	if err := setTablespaceOwner(txn, d); err != nil {
+		return err
+	}
+
+	if err := setTablespaceOptions(txn, d); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error updating tablespace: %w", err)
+	}
+
+	d.SetId(d.Get(tablespaceNameAttr).(string))
+
+	return resourcePostgreSQLTablespaceReadImpl(db, d)
+}
+
+func resourcePostgreSQLTablespaceDelete(db *DBConnection, d *schema.ResourceData) error {
+	// DROP TABLESPACE cannot be executed inside a transaction block.
+	sql := fmt.Sprintf("DROP TABLESPACE %s", pq.QuoteIdentifier(d.Get(tablespaceNameAttr).(string)))
+	if _, err := db.Exec(sql); err != nil {
+		return fmt.Errorf("error dropping tablespace: %w", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func setTablespaceName(txn *sql.Tx, d *schema.ResourceData) error {
+	if !d.HasChange(tablespaceNameAttr) {
+		return nil
+	}
+
+	o, n := d.GetChange(tablespaceNameAttr)
+	sql := fmt.Sprintf(
+		"ALTER TABLESPACE %s RENAME TO %s",
+		pq.QuoteIdentifier(o.(string)), pq.QuoteIdentifier(n.(string)),
+	)
+	if _, err := txn.Exec(sql); err != nil {
+		return fmt.Errorf("error updating tablespace name: %w", err)
+	}
+
+	return nil
+}
+
+func setTablespaceOwner(txn *sql.Tx, d *schema.ResourceData) error {
+	if !d.HasChange(tablespaceOwnerAttr) {
+		return nil
+	}
+
+	owner := d.Get(tablespaceOwnerAttr).(string)
+	if owner == "" {
+		return nil
+	}
+
+	sql := fmt.Sprintf(
+		"ALTER TABLESPACE %s OWNER TO %s",
+		pq.QuoteIdentifier(d.Get(tablespaceNameAttr).(string)), pq.QuoteIdentifier(owner),
+	)
+	if _, err := txn.Exec(sql); err != nil {
+		return fmt.Errorf("error updating tablespace owner: %w", err)
+	}
+
+	return nil
+}
+
+func setTablespaceOptions(txn *sql.Tx, d *schema.ResourceData) error {
+	if !d.HasChange(tablespaceOptionsAttr) {
+		return nil
+	}
+
+	name := pq.QuoteIdentifier(d.Get(tablespaceNameAttr).(string))
+	oraw, nraw := d.GetChange(tablespaceOptionsAttr)
+	oldOptions := oraw.(map[string]any)
+	newOptions := nraw.(map[string]any)
+
+	var toReset []string
+	for k := range oldOptions {
+		if _, ok := newOptions[k]; !ok {
+			toReset = append(toReset, pq.QuoteIdentifier(k))
+		}
+	}
+	if len(toReset) > 0 {
+		sort.Strings(toReset)
+		sql := fmt.Sprintf("ALTER TABLESPACE %s RESET (%s)", name, strings.Join(toReset, ", "))
+		if _, err := txn.Exec(sql); err != nil {
+			return fmt.Errorf("error resetting tablespace options: %w", err)
+		}
+	}
+
+	if len(newOptions) > 0 {
+		sql := fmt.Sprintf("ALTER TABLESPACE %s SET (%s)", name, strings.Join(tablespaceOptionsList(newOptions), ", "))
+		if _, err := txn.Exec(sql); err != nil {
+			return fmt.Errorf("error setting tablespace options: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// tablespaceOptionsList returns the sorted list of options formatted as `name = 'value'`.
+func tablespaceOptionsList(options map[string]any) []string {
+	list := make([]string, 0, len(options))
+	for k, v := range options {
+		list = append(list, fmt.Sprintf("%s = %s", pq.QuoteIdentifier(k), pq.QuoteLiteral(v.(string))))
+	}
+	sort.Strings(list)
+	return list
+}
@@ -0,0 +1,198 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testTablespaceLocation is an empty directory created in the test Docker image (see tests/build/Dockerfile).
+const testTablespaceLocation = "/opt/tablespaces/tf_tests"
+
+func TestAccPostgresqlTablespace_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testSuperuserPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlTablespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "postgresql_role" "owner" {
+	name = "tf_tests_tablespace_owner"
+}
+
+resource "postgresql_tablespace" "test" {
+	name     = "tf_tests_tablespace"
+	location = "%s"
+	options = {
+		random_page_cost = "1.1"
+		seq_page_cost    = "1"
+	}
+}
+`, testTablespaceLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlTablespaceExists("tf_tests_tablespace"),
+					resource.TestCheckResourceAttr("postgresql_tablespace.test", "name", "tf_tests_tablespace"),
+					resource.TestCheckResourceAttr("postgresql_tablespace.test", "location", testTablespaceLocation),
+					resource.TestCheckResourceAttrSet("postgresql_tablespace.test", "owner"),
+					resource.TestCheckResourceAttr("postgresql_tablespace.test", "options.%", "2"),
+					resource.TestCheckResourceAttr("postgresql_tablespace.test", "options.random_page_cost", "1.1"),
+					resource.TestCheckResourceAttr("postgresql_tablespace.test", "options.seq_page_cost", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "postgresql_role" "owner" {
+	name = "tf_tests_tablespace_owner"
+}
+
+resource "postgresql_tablespace" "test" {
+	name     = "tf_tests_tablespace_renamed"
+	owner    = postgresql_role.owner.name
+	location = "%s"
+	options = {
+		effective_io_concurrency = "200"
+	}
+}
+`, testTablespaceLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlTablespaceExists("tf_tests_tablespace_renamed"),
+					resource.TestCheckResourceAttr("postgresql_tablespace.test", "name", "tf_tests_tablespace_renamed"),
+					resource.TestCheckResourceAttr("postgresql_tablespace.test", "owner", "tf_tests_tablespace_owner"),
+					resource.TestCheckResourceAttr("postgresql_tablespace.test", "options.%", "1"),
+					resource.TestCheckResourceAttr("postgresql_tablespace.test", "options.effective_io_concurrency", "200"),
+				),
+			},
+			{
+				ResourceName:      "postgresql_tablespace.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlTablespace_Grant(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testSuperuserPreCheck(t)
+			testCheckCompatibleVersion(t, featurePrivileges)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlTablespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "postgresql_role" "test" {
+	name = "tf_tests_tablespace_user"
+}
+
+resource "postgresql_tablespace" "test" {
+	name     = "tf_tests_tablespace"
+	location = "%s"
+}
+
+resource "postgresql_grant" "test" {
+	database    = "postgres"
+	role        = postgresql_role.test.name
+	object_type = "tablespace"
+	objects     = [postgresql_tablespace.test.name]
+	privileges  = ["CREATE"]
+}
+`, testTablespaceLocation),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("postgresql_grant.test", "id", "tf_tests_tablespace_user_postgres_tablespace_tf_tests_tablespace"),
+					resource.TestCheckResourceAttr("postgresql_grant.test", "privileges.#", "1"),
+					testCheckTablespacePrivilege("tf_tests_tablespace", "tf_tests_tablespace_user", true),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPostgresqlTablespaceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+	db, err := client.Connect()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "postgresql_tablespace" {
+			continue
+		}
+
+		exists, err := checkTablespaceExists(db, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking tablespace %s", err)
+		}
+
+		if exists {
+			return fmt.Errorf("Tablespace still exists after destroy")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckPostgresqlTablespaceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*Client)
+		db, err := client.Connect()
+		if err != nil {
+			return err
+		}
+
+		exists, err := checkTablespaceExists(db, name)
+		if err != nil {
+			return fmt.Errorf("error checking tablespace %s", err)
+		}
+
+		if !exists {
+			return fmt.Errorf("Tablespace %s not found", name)
+		}
+
+		return nil
+	}
+}
+
+func checkTablespaceExists(db QueryAble, name string) (bool, error) {
+	var _rez int
+	err := db.QueryRow("SELECT 1 FROM pg_catalog.pg_tablespace WHERE spcname = $1", name).Scan(&_rez)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("error reading info about tablespace: %s", err)
+	}
+
+	return true, nil
+}
+
+func testCheckTablespacePrivilege(tablespace, role string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*Client)
+		db, err := client.Connect()
+		if err != nil {
+			return err
+		}
+
+		var hasPrivilege bool
+		if err := db.QueryRow("SELECT has_tablespace_privilege($1, $2, 'CREATE')", role, tablespace).Scan(&hasPrivilege); err != nil {
+			return fmt.Errorf("could not check tablespace privilege: %w", err)
+		}
+
+		if hasPrivilege != expected {
+			return fmt.Errorf("role %s CREATE privilege on tablespace %s is %t, expected %t", role, tablespace, hasPrivilege, expected)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,470 @@
+package postgresql
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/lib/pq"
+)
+
+const (
+	viewNameAttr            = "name"
+	viewSchemaAttr          = "schema"
+	viewDatabaseAttr        = "database"
+	viewOwnerAttr           = "owner"
+	viewQueryAttr           = "query"
+	viewDefinitionAttr      = "definition"
+	viewCheckOptionAttr     = "check_option"
+	viewSecurityBarrierAttr = "security_barrier"
+	viewSecurityInvokerAttr = "security_invoker"
+	viewDropCascadeAttr     = "drop_cascade"
+)
+
+func resourcePostgreSQLView() *schema.Resource {
+	return &schema.Resource{
+		Create: PGResourceFunc(resourcePostgreSQLViewCreate),
+		Read:   PGResourceFunc(resourcePostgreSQLViewRead),
+		Update: PGResourceFunc(resourcePostgreSQLViewUpdate),
+		Delete: PGResourceFunc(resourcePostgreSQLViewDelete),
+		Exists: PGResourceExistsFunc(resourcePostgreSQLViewExists),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			viewNameAttr: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the view",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			viewSchemaAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The schema where the view is located. Defaults to public",
+			},
+			viewDatabaseAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The database where the view is located. If not specified, the provider default database is used",
+			},
+			viewOwnerAttr: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ROLE which owns the view",
+			},
+			viewQueryAttr: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The SELECT or VALUES query of the view",
+
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return normalizeViewDefinition(old) == normalizeViewDefinition(new)
+				},
+			},
+			viewDefinitionAttr: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The normalized definition of the view as returned by pg_get_viewdef",
+			},
+			viewCheckOptionAttr: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The check option of an updatable view. One of: LOCAL, CASCADED",
+				ValidateFunc: validation.StringInSlice([]string{"LOCAL", "CASCADED"}, false),
+			},
+			viewSecurityBarrierAttr: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the view is intended to provide row-level security",
+			},
+			viewSecurityInvokerAttr: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the underlying relations are checked against the privileges of the user of the view instead of the view owner",
+			},
+			viewDropCascadeAttr: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, will also drop all the objects that depend on the view",
+			},
+		},
+	}
+}
+
+func resourcePostgreSQLViewCreate(db *DBConnection, d *schema.ResourceData) error {
+	if d.Get(viewSecurityInvokerAttr).(bool) && !db.featureSupported(featureViewSecurityInvoker) {
+		return fmt.Errorf(
+			"security_invoker is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	database := getDatabase(d, db.client.databaseName)
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if err := createOrReplaceView(txn, d); err != nil {
+		return err
+	}
+
+	if err := setViewOwner(txn, d, "VIEW"); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing view: %w", err)
+	}
+
+	d.SetId(generateViewID(d, database))
+
+	return resourcePostgreSQLViewReadImpl(db, d)
+}
+
+func createOrReplaceView(txn *sql.Tx, d *schema.ResourceData) error {
+	options := []string{}
+	if d.Get(viewSecurityBarrierAttr).(bool) {
+		options = append(options, "security_barrier = true")
+	}
+	if d.Get(viewSecurityInvokerAttr).(bool) {
+		options = append(options, "security_invoker = true")
+	}
+
+	// CREATE OR REPLACE VIEW replaces all the options of the view, the ones not specified are reset.
+	b := bytes.NewBufferString("CREATE OR REPLACE VIEW ")
+	fmt.Fprint(b, getViewQuotedName(d))
+	if len(options) > 0 {
+		fmt.Fprint(b, " WITH (", strings.Join(options, ", "), ")")
+	}
+	fmt.Fprint(b, " AS ", strings.TrimSuffix(strings.TrimSpace(d.Get(viewQueryAttr).(string)), ";"))
+	if v, ok := d.GetOk(viewCheckOptionAttr); ok {
+		fmt.Fprint(b, " WITH ", v.(string), " CHECK OPTION")
+	}
+
+	if _, err := txn.Exec(b.String()); err != nil {
+		return fmt.Errorf("error creating view %s: %w", d.Get(viewNameAttr).(string), err)
+	}
+
+	return nil
+}
+
+func resourcePostgreSQLViewExists(db *DBConnection, d *schema.ResourceData) (bool, error) {
+	database, schemaName, viewName, err := getDBViewName(d, db.client.databaseName)
+	if err != nil {
+		return false, err
+	}
+
+	// Check if the database exists
+	exists, err := dbExists(db, database)
+	if err != nil || !exists {
+		return false, err
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return false, err
+	}
+	defer deferredRollback(txn)
+
+	return viewExists(txn, schemaName, viewName, "v")
+}
+
+func resourcePostgreSQLViewRead(db *DBConnection, d *schema.ResourceData) error {
+	return resourcePostgreSQLViewReadImpl(db, d)
+}
+
+func resourcePostgreSQLViewReadImpl(db *DBConnection, d *schema.ResourceData) error {
+	database, schemaName, viewName, err := getDBViewName(d, db.client.databaseName)
+	if err != nil {
+		return err
+	}
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	var viewOwner, viewDefinition string
+	var viewOptions []string
+
+	query := `SELECT pg_catalog.pg_get_userbyid(c.relowner), pg_catalog.pg_get_viewdef(c.oid), c.reloptions ` +
+		`FROM pg_catalog.pg_class c ` +
+		`JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace ` +
+		`WHERE n.nspname = $1 AND c.relname = $2 AND c.relkind = 'v'`
+	err = txn.QueryRow(query, schemaName, viewName).Scan(&viewOwner, &viewDefinition, pq.Array(&viewOptions))
+	switch {
+	case err == sql.ErrNoRows:
+		log.Printf("[WARN] PostgreSQL view (%s.%s) not found in database %s", schemaName, viewName, database)
+		d.SetId("")
+		return nil
+	case err != nil:
+		return fmt.Errorf("error reading view: %w", err)
+	}
+
+	options := parseRelOptions(viewOptions)
+
+	d.Set(viewNameAttr, viewName)
+	d.Set(viewSchemaAttr, schemaName)
+	d.Set(viewDatabaseAttr, database)
+	d.Set(viewOwnerAttr, viewOwner)
+	d.Set(viewCheckOptionAttr, strings.ToUpper(options["check_option"]))
+	d.Set(viewSecurityBarrierAttr, options["security_barrier"] == "true" || options["security_barrier"] == "on")
+	d.Set(viewSecurityInvokerAttr, options["security_invoker"] == "true" || options["security_invoker"] == "on")
+	setViewQuery(d, viewDefinition)
+	d.SetId(generateViewID(d, database))
+
+	return nil
+}
+
+// setViewQuery sets the query and definition attributes from the output of pg_get_viewdef.
+// PostgreSQL rewrites the query of the view, so the configured query is kept as long as the definition
+// stored in the database is the same as the one read after the last apply.
+func setViewQuery(d *schema.ResourceData, viewDefinition string) {
+	definition := normalizeViewDefinition(viewDefinition)
+	stateDefinition := d.Get(viewDefinitionAttr).(string)
+
+	if d.Get(viewQueryAttr).(string) == "" || (stateDefinition != "" && stateDefinition != definition) {
+		d.Set(viewQueryAttr, definition)
+	}
+	d.Set(viewDefinitionAttr, definition)
+}
+
+func resourcePostgreSQLViewUpdate(db *DBConnection, d *schema.ResourceData) error {
+	if d.Get(viewSecurityInvokerAttr).(bool) && !db.featureSupported(featureViewSecurityInvoker) {
+		return fmt.Errorf(
+			"security_invoker is not supported for this Postgres version (%s)",
+			db.version,
+		)
+	}
+
+	database := getDatabase(d, db.client.databaseName)
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	if err := setViewName(txn, d, database, "VIEW"); err != nil {
+		return err
+	}
+
+	if d.HasChanges(viewQueryAttr, viewCheckOptionAttr, viewSecurityBarrierAttr, viewSecurityInvokerAttr) {
+		if err := createOrReplaceView(txn, d); err != nil {
+			return err
+		}
+		// Reset the definition so the new query is read back from the database
+		d.Set(viewDefinitionAttr, "")
+	}
+
+	if err := setViewOwner(txn, d, "VIEW"); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing view: %w", err)
+	}
+
+	return resourcePostgreSQLViewReadImpl(db, d)
+}
+
+func setViewName(txn *sql.Tx, d *schema.ResourceData, databaseName, objectType string) error {
+	if !d.HasChange(viewNameAttr) {
+		return nil
+	}
+
+	oraw, nraw := d.GetChange(viewNameAttr)
+	o := oraw.(string)
+	n := nraw.(string)
+	if n == "" {
+		return errors.New("error setting view name to an empty string")
+	}
+
+	schemaName := d.Get(viewSchemaAttr).(string)
+	sql := fmt.Sprintf(
+		"ALTER %s %s.%s RENAME TO %s",
+		objectType, pq.QuoteIdentifier(schemaName), pq.QuoteIdentifier(o), pq.QuoteIdentifier(n),
+	)
+	if _, err := txn.Exec(sql); err != nil {
+		return fmt.Errorf("error updating %s NAME: %w", strings.ToLower(objectType), err)
+	}
+	d.SetId(generateViewID(d, databaseName))
+
+	return nil
+}
+
+func setViewOwner(txn *sql.Tx, d *schema.ResourceData, objectType string) error {
+	if !d.HasChange(viewOwnerAttr) {
+		return nil
+	}
+
+	viewOwner := d.Get(viewOwnerAttr).(string)
+	if viewOwner == "" {
+		return nil
+	}
+
+	// If the authenticated user is not a superuser (e.g. on AWS RDS)
+	// it needs to be a member of the new owner.
+	return withRolesGranted(txn, []string{viewOwner}, func() error {
+		sql := fmt.Sprintf("ALTER %s %s OWNER TO %s", objectType, getViewQuotedName(d), pq.QuoteIdentifier(viewOwner))
+		if _, err := txn.Exec(sql); err != nil {
+			return fmt.Errorf("error updating %s OWNER: %w", strings.ToLower(objectType), err)
+		}
+		return nil
+	})
+}
+
+func resourcePostgreSQLViewDelete(db *DBConnection, d *schema.ResourceData) error {
+	return dropView(db, d, "VIEW", "v")
+}
+
+func dropView(db *DBConnection, d *schema.ResourceData, objectType, relkind string) error {
+	database := getDatabase(d, db.client.databaseName)
+
+	txn, err := startTransaction(db.client, database)
+	if err != nil {
+		return err
+	}
+	defer deferredRollback(txn)
+
+	exists, err := viewExists(txn, d.Get(viewSchemaAttr).(string), d.Get(viewNameAttr).(string), relkind)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	owner := d.Get(viewOwnerAttr).(string)
+
+	if err := withRolesGranted(txn, []string{owner}, func() error {
+		dropMode := "RESTRICT"
+		if d.Get(viewDropCascadeAttr).(bool) {
+			dropMode = "CASCADE"
+		}
+
+		sql := fmt.Sprintf("DROP %s %s %s", objectType, getViewQuotedName(d), dropMode)
+		if _, err := txn.Exec(sql); err != nil {
+			return fmt.Errorf("error deleting %s: %w", strings.ToLower(objectType), err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("error committing %s: %w", strings.ToLower(objectType), err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+var viewDefinitionSpaceRe = regexp.MustCompile(`\s+`)
+
+// normalizeViewDefinition normalizes the query of a view so the output of pg_get_viewdef
+// can be compared with the configured query: whitespaces are collapsed and the final semicolon removed.
+func normalizeViewDefinition(definition string) string {
+	definition = viewDefinitionSpaceRe.ReplaceAllString(strings.TrimSpace(definition), " ")
+	definition = strings.TrimSpace(strings.TrimSuffix(definition, ";"))
+	definition = strings.ReplaceAll(definition, "( ", "(")
+	definition = strings.ReplaceAll(definition, " )", ")")
+	return definition
+}
+
+// parseRelOptions parses the reloptions of a relation (e.g.: {security_barrier=true,check_option=local}).
+func parseRelOptions(relOptions []string) map[string]string {
+	options := make(map[string]string, len(relOptions))
+	for _, option := range relOptions {
+		parts := strings.SplitN(option, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		options[parts[0]] = parts[1]
+	}
+	return options
+}
+
+func viewExists(txn *sql.Tx, schemaName, viewName, relkind string) (bool, error) {
+	var _rez bool
+	query := `SELECT TRUE FROM pg_catalog.pg_class c ` +
+		`JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace ` +
+		`WHERE n.nspname = $1 AND c.relname = $2 AND c.relkind = $3`
+	err := txn.QueryRow(query, schemaName, viewName, relkind).Scan(&_rez)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("could not check if view exists: %w", err)
+	}
+
+	return true, nil
+}
+
+func getViewQuotedName(d *schema.ResourceData) string {
+	schemaName := "public"
+	if v, ok := d.GetOk(viewSchemaAttr); ok {
+		schemaName = v.(string)
+	}
+	return fmt.Sprintf("%s.%s", pq.QuoteIdentifier(schemaName), pq.QuoteIdentifier(d.Get(viewNameAttr).(string)))
+}
+
+func generateViewID(d *schema.ResourceData, databaseName string) string {
+	schemaName := "public"
+	if v, ok := d.GetOk(viewSchemaAttr); ok {
+		schemaName = v.(string)
+	}
+
+	return strings.Join([]string{
+		getDatabase(d, databaseName),
+		schemaName,
+		d.Get(viewNameAttr).(string),
+	}, ".")
+}
+
+// getDBViewName returns database, schema and view name of a view or a materialized view.
+// If we are importing this resource, they will be parsed from the resource ID
+// (it will return an error if parsing failed) otherwise they will be simply get from the state.
+func getDBViewName(d *schema.ResourceData, databaseName string) (string, string, string, error) {
+	database := getDatabase(d, databaseName)
+	schemaName := "public"
+	if v, ok := d.GetOk(viewSchemaAttr); ok {
+		schemaName = v.(string)
+	}
+	viewName := d.Get(viewNameAttr).(string)
+
+	// When importing, we have to parse the ID to find database, schema and view names.
+	if viewName == "" {
+		parsed := strings.Split(d.Id(), ".")
+		if len(parsed) != 3 {
+			return "", "", "", fmt.Errorf("view ID %s has not the expected format 'database.schema.view': %v", d.Id(), parsed)
+		}
+		database = parsed[0]
+		schemaName = parsed[1]
+		viewName = parsed[2]
+	}
+	return database, schemaName, viewName, nil
+}
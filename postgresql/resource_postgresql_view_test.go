@@ -0,0 +1,213 @@
+package postgresql
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeViewDefinition(t *testing.T) {
+	cases := []struct {
+		definition string
+		expected   string
+	}{
+		{
+			definition: " SELECT test_table.val\n   FROM test_table;",
+			expected:   "SELECT test_table.val FROM test_table",
+		},
+		{
+			definition: "SELECT val FROM test_table WHERE ( val <> '' )",
+			expected:   "SELECT val FROM test_table WHERE (val <> '')",
+		},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expected, normalizeViewDefinition(c.definition))
+	}
+}
+
+func TestParseRelOptions(t *testing.T) {
+	assert.Equal(t, map[string]string{}, parseRelOptions(nil))
+	assert.Equal(
+		t,
+		map[string]string{"security_barrier": "true", "check_option": "local"},
+		parseRelOptions([]string{"security_barrier=true", "check_option=local"}),
+	)
+}
+
+func TestAccPostgresqlView_Basic(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	dropTables := createTestTables(t, dbSuffix, []string{"test_view_table"}, "")
+	defer dropTables()
+
+	dbName, roleName := getTestDBNames(dbSuffix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlViewDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccPostgresqlViewConfig, dbName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlViewExists("postgresql_view.test", "v"),
+					resource.TestCheckResourceAttr("postgresql_view.test", "name", "test_view"),
+					resource.TestCheckResourceAttr("postgresql_view.test", "schema", "public"),
+					resource.TestCheckResourceAttr("postgresql_view.test", "owner", roleName),
+					resource.TestCheckResourceAttr("postgresql_view.test", "security_barrier", "true"),
+					resource.TestCheckResourceAttr("postgresql_view.test", "check_option", "LOCAL"),
+					resource.TestCheckResourceAttrSet("postgresql_view.test", "definition"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccPostgresqlViewConfigUpdated, dbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlViewExists("postgresql_view.test", "v"),
+					resource.TestCheckResourceAttr("postgresql_view.test", "name", "test_view_renamed"),
+					resource.TestCheckResourceAttr("postgresql_view.test", "security_barrier", "false"),
+					resource.TestCheckResourceAttr("postgresql_view.test", "check_option", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPostgresqlView_Drift(t *testing.T) {
+	skipIfNotAcc(t)
+
+	dbSuffix, teardown := setupTestDatabase(t, true, true)
+	defer teardown()
+
+	dropTables := createTestTables(t, dbSuffix, []string{"test_view_table"}, "")
+	defer dropTables()
+
+	dbName, roleName := getTestDBNames(dbSuffix)
+	config := getTestConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPostgresqlViewDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccPostgresqlViewConfig, dbName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPostgresqlViewExists("postgresql_view.test", "v"),
+				),
+			},
+			{
+				PreConfig: func() {
+					dbExecute(t, config.connStr(dbName), "CREATE OR REPLACE VIEW test_view AS SELECT val, test_column_one FROM test_view_table")
+				},
+				Config:             fmt.Sprintf(testAccPostgresqlViewConfig, dbName, roleName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPostgresqlViewDestroy(s *terraform.State) error {
+	return testAccCheckPostgresqlViewDestroyWithType(s, "postgresql_view", "v")
+}
+
+func testAccCheckPostgresqlViewDestroyWithType(s *terraform.State, resourceType, relkind string) error {
+	client := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resourceType {
+			continue
+		}
+
+		exists, err := checkViewExists(client, rs.Primary.ID, relkind)
+		if err != nil {
+			return fmt.Errorf("error checking view %s", err)
+		}
+
+		if exists {
+			return fmt.Errorf("View still exists after destroy")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckPostgresqlViewExists(n, relkind string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Client)
+		exists, err := checkViewExists(client, rs.Primary.ID, relkind)
+		if err != nil {
+			return fmt.Errorf("error checking view %s", err)
+		}
+
+		if !exists {
+			return fmt.Errorf("View not found")
+		}
+
+		return nil
+	}
+}
+
+func checkViewExists(client *Client, viewID, relkind string) (bool, error) {
+	parts := strings.Split(viewID, ".")
+	if len(parts) != 3 {
+		return false, fmt.Errorf("unexpected view ID %s", viewID)
+	}
+
+	txn, err := startTransaction(client, parts[0])
+	if err != nil {
+		return false, err
+	}
+	defer deferredRollback(txn)
+
+	return viewExists(txn, parts[1], parts[2], relkind)
+}
+
+const testAccPostgresqlViewConfig = `
+resource "postgresql_view" "test" {
+  database         = "%s"
+  name             = "test_view"
+  owner            = "%s"
+  security_barrier = true
+  check_option     = "LOCAL"
+
+  query = <<-EOT
+    SELECT val
+    FROM test_view_table
+    WHERE val <> ''
+  EOT
+}
+`
+
+const testAccPostgresqlViewConfigUpdated = `
+resource "postgresql_view" "test" {
+  database = "%s"
+  name     = "test_view_renamed"
+
+  query = <<-EOT
+    SELECT val, test_column_one
+    FROM test_view_table
+  EOT
+}
+`